@@ -9,6 +9,7 @@ import (
 	sdklog "go.opentelemetry.io/otel/sdk/log"
 
 	"github.com/thediveo/otelcheck/exporters/chanlog"
+	"github.com/thediveo/otelcheck/lotel"
 )
 
 // Please consider using [github.com/thediveo/otelcheck/lotel/testlogger.New]
@@ -39,3 +40,25 @@ func Example() {
 	}
 	// Output: DO'H!
 }
+
+// Filter the exported log records so that only the log records from a
+// particular instrumentation scope make it into the log record channel.
+func ExampleWithFilter() {
+	exporter, _ := chanlog.New(
+		chanlog.WithCap(10),
+		chanlog.WithFilter(lotel.BeARecord(lotel.HaveScopeName("ourpkg"))))
+	processor := sdklog.NewSimpleProcessor(exporter)
+	provider := sdklog.NewLoggerProvider(sdklog.WithProcessor(processor))
+	defer func() { _ = processor.Shutdown(context.TODO()) }()
+	ch := exporter.Ch()
+
+	r := log.Record{}
+	r.SetBody(log.StringValue("noise"))
+	provider.Logger("theirpkg").Emit(context.TODO(), r)
+	r.SetBody(log.StringValue("signal"))
+	provider.Logger("ourpkg").Emit(context.TODO(), r)
+
+	rec := <-ch
+	fmt.Println(rec.Body().AsString(), exporter.Filtered())
+	// Output: signal 1
+}
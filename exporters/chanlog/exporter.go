@@ -16,6 +16,9 @@ package chanlog
 
 import (
 	"context"
+	"errors"
+	"fmt"
+	"sync"
 	"sync/atomic"
 
 	sdklog "go.opentelemetry.io/otel/sdk/log"
@@ -33,9 +36,52 @@ type RecordsChannel chan sdklog.Record
 // Exporter writes log records to a Go channel of type [RecordsChannel] (chan of
 // [sdklog.Record]). Use [New] to create an Exporter.
 type Exporter struct {
-	ch atomic.Pointer[RecordsChannel]
+	ch       atomic.Pointer[RecordsChannel]
+	overflow OverflowPolicy
+	dropped  atomic.Uint64
+	filter   func(sdklog.Record) bool
+	rejected RecordsChannel
+	filtered atomic.Uint64
+
+	mu   sync.RWMutex           // protects the subscribers and shut fields
+	subs map[*Exporter]struct{} // subscribers, see Subscribe
+	shut bool                   // shut down, no new subscriptions anymore
+
+	sendMu   sync.RWMutex  // held for reading while sending to the channel
+	done     chan struct{} // closed on shutdown to interrupt blocked sends
+	doneOnce sync.Once
 }
 
+// OverflowPolicy specifies how an [Exporter] handles exporting log records when
+// its log record channel is full.
+type OverflowPolicy uint8
+
+const (
+	// OverflowBlock blocks until there is room in the channel or the export
+	// context is done.
+	OverflowBlock OverflowPolicy = iota
+	// OverflowDropNewest drops the log record to be exported.
+	OverflowDropNewest
+	// OverflowDropOldest drops the oldest log record still waiting in the
+	// channel to make room for the log record to be exported. For unbuffered
+	// channels, OverflowDropOldest behaves like OverflowDropNewest.
+	OverflowDropOldest
+	// OverflowFail drops the log record to be exported as well as any
+	// remaining log records of the same export, and then fails the export
+	// with [ErrOverflow].
+	OverflowFail
+)
+
+// ErrOverflow is returned by [Exporter.Export] when the log record channel is
+// full and the exporter has been configured with the [OverflowFail] overflow
+// policy.
+var ErrOverflow = errors.New("chanlog: log record channel overflow")
+
+// ErrBroadcastFilter is returned by [New] when [WithFilter] or [WithRejected]
+// is combined with [WithBroadcast]; in broadcast mode, configure filters on the
+// individual subscribers instead, see [Exporter.Subscribe].
+var ErrBroadcastFilter = errors.New("chanlog: WithFilter and WithRejected cannot be combined with WithBroadcast")
+
 // statically ensure that we fulfill the OTel logging SDK's Exporter interface.
 var _ (sdklog.Exporter) = (*Exporter)(nil)
 
@@ -45,24 +91,44 @@ var _ (sdklog.Exporter) = (*Exporter)(nil)
 // a suitable channel will be implicitly created and can later be retrieved
 // using [Exporter.Ch]. Please note that the minimum configurable buffer size of
 // an implicitly created channel is 1.
+//
+// When configured using [WithBroadcast], the exporter has no log record channel
+// of its own and instead exports log records only to its subscribers, see
+// [Exporter.Subscribe]. As there is no channel to filter log records for,
+// combining WithBroadcast with [WithFilter] or [WithRejected] fails with
+// [ErrBroadcastFilter].
 func New(opts ...Option) (*Exporter, error) {
 	var o options
 	for _, opt := range opts {
 		opt(&o)
 	}
+	if o.err != nil {
+		return nil, o.err
+	}
+	if o.broadcast && (o.filter != nil || o.rejected != nil) {
+		return nil, ErrBroadcastFilter
+	}
 
 	if o.ch == nil {
 		o.ch = make(RecordsChannel, max(o.capacity, 1))
 	}
 
-	e := &Exporter{}
+	e := &Exporter{
+		overflow: o.overflow,
+		filter:   o.filter,
+		rejected: o.rejected,
+		done:     make(chan struct{}),
+	}
+	if o.broadcast {
+		return e, nil
+	}
 	ch := o.ch
 	e.ch.Store(&ch)
 	return e, nil
 }
 
 // Ch returns the log record channel, or nil after [Exporter.Shutdown] has been
-// called.
+// called or when in broadcast mode.
 func (e *Exporter) Ch() RecordsChannel {
 	ch := e.ch.Load()
 	if ch == nil {
@@ -71,28 +137,161 @@ func (e *Exporter) Ch() RecordsChannel {
 	return *ch
 }
 
-// Export log records to the configured channel. It does nothing after
-// [Exporter.Shutdown] has been called.
+// Export log records to the configured channel, handling a full channel as
+// configured using [WithOverflow], as well as to all subscribers. It does
+// nothing after [Exporter.Shutdown] has been called.
+//
+// When blocked on a full channel, Export returns as soon as either the context
+// is done or the exporter gets shut down, counting the current and all
+// remaining log records as dropped (see [Exporter.Dropped]). When shut down,
+// Export then returns an error wrapping [ErrShutdown]. The same applies to
+// subscribers getting unsubscribed.
 func (e *Exporter) Export(ctx context.Context, records []sdklog.Record) error {
+	err := e.exportToChannel(ctx, records)
+	// take a snapshot of the current subscribers so that we don't block
+	// (un)subscribing and shutting down while exporting to subscribers with
+	// full channels.
+	e.mu.RLock()
+	subs := make([]*Exporter, 0, len(e.subs))
+	for sub := range e.subs {
+		subs = append(subs, sub)
+	}
+	e.mu.RUnlock()
+	for _, sub := range subs {
+		err = errors.Join(err, sub.Export(ctx, records))
+	}
+	return err
+}
+
+// exportToChannel exports the passed log records to the exporter's own log
+// record channel, if any.
+func (e *Exporter) exportToChannel(ctx context.Context, records []sdklog.Record) error {
+	// keep Shutdown from closing the channel while we're sending to it.
+	e.sendMu.RLock()
+	defer e.sendMu.RUnlock()
 	ch := e.ch.Load()
 	if ch == nil {
 		return ctx.Err()
 	}
 
-	for _, rec := range records {
-		select {
-		case *ch <- rec:
-		case <-ctx.Done():
-			return ctx.Err()
+	for idx, rec := range records {
+		if e.filter != nil && !e.filter(rec) {
+			e.reject(rec)
+			continue
+		}
+		switch e.overflow {
+		case OverflowDropNewest:
+			select {
+			case *ch <- rec:
+			default:
+				e.dropped.Add(1)
+			}
+		case OverflowDropOldest:
+			e.sendDroppingOldest(*ch, rec)
+		case OverflowFail:
+			select {
+			case *ch <- rec:
+			default:
+				e.dropRemaining(records[idx:])
+				return ErrOverflow
+			}
+		default:
+			select {
+			case *ch <- rec:
+			case <-ctx.Done():
+				e.dropRemaining(records[idx:])
+				return ctx.Err()
+			case <-e.done:
+				e.dropRemaining(records[idx:])
+				return fmt.Errorf("%w while exporting, dropped %d log records",
+					ErrShutdown, len(records)-idx)
+			}
 		}
 	}
 	return ctx.Err()
 }
 
+// dropRemaining counts the first of the passed log records, which has already
+// passed the filter, as well as all remaining log records passing the filter
+// as dropped.
+func (e *Exporter) dropRemaining(records []sdklog.Record) {
+	dropped := uint64(1)
+	for _, rec := range records[1:] {
+		if e.filter == nil || e.filter(rec) {
+			dropped++
+		}
+	}
+	e.dropped.Add(dropped)
+}
+
+// reject counts the passed log record as filtered and sends it to the rejected
+// log record channel, if configured, without blocking.
+func (e *Exporter) reject(rec sdklog.Record) {
+	e.filtered.Add(1)
+	if e.rejected == nil {
+		return
+	}
+	select {
+	case e.rejected <- rec:
+	default:
+	}
+}
+
+// sendDroppingOldest sends the passed log record to the passed channel, dropping
+// the oldest log records from the channel as necessary to make room.
+func (e *Exporter) sendDroppingOldest(ch RecordsChannel, rec sdklog.Record) {
+	for {
+		select {
+		case ch <- rec:
+			return
+		default:
+		}
+		if cap(ch) == 0 {
+			e.dropped.Add(1)
+			return
+		}
+		select {
+		case <-ch:
+			e.dropped.Add(1)
+		default:
+		}
+	}
+}
+
+// Dropped returns the number of log records dropped so far because the log
+// record channel was full, or because an export blocked on a full channel
+// was canceled or interrupted by shutting down. Log records not passing the filter configured using
+// [WithFilter] never count as dropped. Tests should assert that no log records were lost:
+//
+//	Expect(exp.Dropped()).To(BeZero())
+func (e *Exporter) Dropped() uint64 {
+	return e.dropped.Load()
+}
+
+// Filtered returns the number of log records so far that did not pass the
+// filter configured using [WithFilter].
+func (e *Exporter) Filtered() uint64 {
+	return e.filtered.Load()
+}
+
 // Shutdown the Exporter so that any later calls to [Exporter.Export] will
 // perform no operation anymore and additionally closes the writing end of the
-// exporter's log record channel.
-func (e *Exporter) Shutdown(context.Context) error {
+// exporter's log record channel as well as of all subscriber channels.
+func (e *Exporter) Shutdown(ctx context.Context) error {
+	e.mu.Lock()
+	e.shut = true
+	subs := e.subs
+	e.subs = nil
+	e.mu.Unlock()
+	for sub := range subs {
+		_ = sub.Shutdown(ctx)
+	}
+
+	// interrupt any export blocked on a full channel and then wait for all
+	// in-flight exports to finish before closing the channel.
+	e.doneOnce.Do(func() { close(e.done) })
+	e.sendMu.Lock()
+	defer e.sendMu.Unlock()
 	ch := e.ch.Swap(nil)
 	if ch == nil {
 		return nil
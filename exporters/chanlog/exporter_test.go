@@ -123,4 +123,153 @@ var _ = Describe("OTel log record channel exporter", func() {
 		Eventually(done).Should(BeClosed())
 	})
 
+	When("the channel overflows", func() {
+
+		bodies := func(ch RecordsChannel) []log.Value {
+			var values []log.Value
+			for len(ch) > 0 {
+				r := <-ch
+				values = append(values, r.Body())
+			}
+			return values
+		}
+
+		records := func(n int) []sdklog.Record {
+			rs := make([]sdklog.Record, 0, n)
+			for i := range n {
+				r := rf.NewRecord()
+				r.SetBody(log.IntValue(i))
+				rs = append(rs, r)
+			}
+			return rs
+		}
+
+		It("drops the newest records", func(ctx context.Context) {
+			e := Successful(New(WithCap(2), WithOverflow(OverflowDropNewest)))
+			Expect(e.Export(ctx, records(3))).To(Succeed())
+			Expect(e.Dropped()).To(Equal(uint64(1)))
+			Expect(bodies(e.Ch())).To(ConsistOf(log.IntValue(0), log.IntValue(1)))
+		})
+
+		It("drops the oldest records", func(ctx context.Context) {
+			e := Successful(New(WithCap(2), WithOverflow(OverflowDropOldest)))
+			Expect(e.Export(ctx, records(4))).To(Succeed())
+			Expect(e.Dropped()).To(Equal(uint64(2)))
+			Expect(bodies(e.Ch())).To(HaveExactElements(log.IntValue(2), log.IntValue(3)))
+		})
+
+		It("drops the newest records for unbuffered channels", func(ctx context.Context) {
+			e := Successful(New(WithChannel(make(RecordsChannel)), WithOverflow(OverflowDropOldest)))
+			Expect(e.Export(ctx, records(2))).To(Succeed())
+			Expect(e.Dropped()).To(Equal(uint64(2)))
+		})
+
+		It("fails the export", func(ctx context.Context) {
+			e := Successful(New(WithCap(2), WithOverflow(OverflowFail)))
+			Expect(e.Export(ctx, records(5))).To(MatchError(ErrOverflow))
+			Expect(e.Dropped()).To(Equal(uint64(3)))
+			Expect(bodies(e.Ch())).To(HaveExactElements(log.IntValue(0), log.IntValue(1)))
+		})
+
+		It("fails the export counting only records passing the filter", func(ctx context.Context) {
+			e := Successful(New(WithCap(1), WithOverflow(OverflowFail),
+				WithFilter(func(r sdklog.Record) bool { return r.Body().AsInt64()%2 == 0 })))
+			Expect(e.Export(ctx, records(6))).To(MatchError(ErrOverflow))
+			Expect(e.Dropped()).To(Equal(uint64(2)))
+			Expect(bodies(e.Ch())).To(HaveExactElements(log.IntValue(0)))
+		})
+
+		It("blocks by default, counting records lost on cancellation", func(ctx context.Context) {
+			e := Successful(New(WithCap(1)))
+			Expect(e.Export(ctx, records(1))).To(Succeed())
+			Expect(e.Dropped()).To(BeZero())
+
+			exportCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+			defer cancel()
+			Expect(e.Export(exportCtx, records(3))).To(MatchError(context.DeadlineExceeded))
+			Expect(e.Dropped()).To(Equal(uint64(3)))
+		})
+
+		It("counts only records passing the filter as lost on cancellation", func(ctx context.Context) {
+			e := Successful(New(WithCap(1),
+				WithFilter(func(r sdklog.Record) bool { return r.Body().AsInt64() != 2 })))
+			exportCtx, cancel := context.WithTimeout(ctx, 50*time.Millisecond)
+			defer cancel()
+			Expect(e.Export(exportCtx, records(4))).To(MatchError(context.DeadlineExceeded))
+			Expect(e.Dropped()).To(Equal(uint64(2)))
+			Expect(e.Filtered()).To(BeZero())
+		})
+
+	})
+
+	When("filtering", func() {
+
+		It("rejects invalid filters", func() {
+			Expect(New(WithFilter(42))).Error().To(
+				MatchError(ContainSubstring("filter must be func(sdklog.Record) bool or GomegaMatcher, got int")))
+		})
+
+		It("forwards only records passing a predicate", func(ctx context.Context) {
+			e := Successful(New(WithCap(10), WithFilter(func(r sdklog.Record) bool {
+				return r.Body().AsInt64()%2 == 0
+			})))
+			for i := range 4 {
+				r := rf.NewRecord()
+				r.SetBody(log.IntValue(i))
+				Expect(e.Export(ctx, []sdklog.Record{r})).To(Succeed())
+			}
+			Expect(e.Filtered()).To(Equal(uint64(2)))
+			Expect(e.Ch()).To(HaveLen(2))
+			Expect(e.Ch()).To(Receive(HaveField("Body()", log.IntValue(0))))
+			Expect(e.Ch()).To(Receive(HaveField("Body()", log.IntValue(2))))
+		})
+
+		It("forwards only records matching a Gomega matcher", func(ctx context.Context) {
+			rejected := make(RecordsChannel, 1)
+			e := Successful(New(WithCap(10),
+				WithFilter(HaveField("Body()", log.IntValue(1))),
+				WithRejected(rejected)))
+			var records []sdklog.Record
+			for i := range 3 {
+				r := rf.NewRecord()
+				r.SetBody(log.IntValue(i))
+				records = append(records, r)
+			}
+			Expect(e.Export(ctx, records)).To(Succeed())
+			Expect(e.Filtered()).To(Equal(uint64(2)))
+			Expect(e.Ch()).To(HaveLen(1))
+			Expect(e.Ch()).To(Receive(HaveField("Body()", log.IntValue(1))))
+			Expect(rejected).To(HaveLen(1), "must not block on a full rejected channel")
+			Expect(rejected).To(Receive(HaveField("Body()", log.IntValue(0))))
+		})
+
+		It("rejects filters in broadcast mode", func(ctx context.Context) {
+			Expect(New(WithBroadcast(), WithFilter(HaveKey("foo")))).Error().To(
+				MatchError(ErrBroadcastFilter))
+			Expect(New(WithRejected(make(RecordsChannel, 1)), WithBroadcast())).Error().To(
+				MatchError(ErrBroadcastFilter))
+
+			e := Successful(New(WithBroadcast()))
+			ch, unsub := Successful2R(e.Subscribe(WithCap(10), WithFilter(func(r sdklog.Record) bool {
+				return r.Body().AsInt64() == 1
+			})))
+			defer unsub()
+			for i := range 2 {
+				r := rf.NewRecord()
+				r.SetBody(log.IntValue(i))
+				Expect(e.Export(ctx, []sdklog.Record{r})).To(Succeed())
+			}
+			Expect(ch).To(HaveLen(1))
+			Expect(ch).To(Receive(HaveField("Body()", log.IntValue(1))))
+		})
+
+		It("treats matcher errors as not matching", func(ctx context.Context) {
+			e := Successful(New(WithFilter(HaveKey("foo"))))
+			Expect(e.Export(ctx, []sdklog.Record{rf.NewRecord()})).To(Succeed())
+			Expect(e.Filtered()).To(Equal(uint64(1)))
+			Expect(e.Ch()).To(BeEmpty())
+		})
+
+	})
+
 })
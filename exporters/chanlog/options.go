@@ -14,12 +14,25 @@
 
 package chanlog
 
+import (
+	"fmt"
+
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+
+	ty "github.com/onsi/gomega/types"
+)
+
 // Option configures a log record channel [Exporter].
 type Option func(*options)
 
 type options struct {
-	capacity int
-	ch       RecordsChannel
+	capacity  int
+	ch        RecordsChannel
+	overflow  OverflowPolicy
+	filter    func(sdklog.Record) bool
+	rejected  RecordsChannel
+	broadcast bool
+	err       error
 }
 
 // WithCap configures the capacity of the implicit log record channel, unless an
@@ -38,3 +51,62 @@ func WithChannel(ch RecordsChannel) func(o *options) {
 		o.ch = ch
 	}
 }
+
+// WithOverflow configures what the exporter does when the log record channel is
+// full. The default is [OverflowBlock].
+func WithOverflow(policy OverflowPolicy) func(o *options) {
+	return func(o *options) {
+		o.overflow = policy
+	}
+}
+
+// WithFilter configures the exporter to forward only those log records to the
+// log record channel that pass the specified filter. All other log records are
+// counted (see [Exporter.Filtered]) and optionally sent to a rejected log
+// record channel configured using [WithRejected].
+//
+// The filter can be either a predicate of type func([sdklog.Record]) bool or a
+// Gomega matcher, such as
+//
+//	lotel.BeARecord(lotel.HaveScopeName("ourpkg"))
+//
+// A Gomega matcher returning an error is treated as not matching. Any other
+// type of filter makes [New] fail.
+func WithFilter(filter any) func(o *options) {
+	return func(o *options) {
+		switch filter := filter.(type) {
+		case func(sdklog.Record) bool:
+			o.filter = filter
+		case ty.GomegaMatcher:
+			o.filter = func(r sdklog.Record) bool {
+				success, err := filter.Match(r)
+				return err == nil && success
+			}
+		default:
+			o.err = fmt.Errorf("chanlog: filter must be func(sdklog.Record) bool or GomegaMatcher, got %T",
+				filter)
+		}
+	}
+}
+
+// WithRejected configures a secondary log record channel receiving the log
+// records not passing the filter configured using [WithFilter]. Sending
+// rejected log records never blocks: when the rejected channel is full,
+// rejected log records are silently dropped. The rejected channel is not
+// closed when the exporter shuts down.
+func WithRejected(ch RecordsChannel) func(o *options) {
+	return func(o *options) {
+		o.rejected = ch
+	}
+}
+
+// WithBroadcast configures the exporter to not have a log record channel of its
+// own, but instead to export log records only to its subscribers, see
+// [Exporter.Subscribe]. Any [WithCap] and [WithChannel] configuration is
+// ignored, while combining WithBroadcast with [WithFilter] or [WithRejected]
+// is an error; configure filters on the subscribers instead.
+func WithBroadcast() func(o *options) {
+	return func(o *options) {
+		o.broadcast = true
+	}
+}
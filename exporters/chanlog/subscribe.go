@@ -0,0 +1,72 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package chanlog
+
+import (
+	"context"
+	"errors"
+)
+
+// ErrShutdown is returned by [Exporter.Subscribe] after the exporter has been
+// shut down. Errors returned by [Exporter.Export] when shutting down the
+// exporter interrupted a blocked export wrap ErrShutdown.
+var ErrShutdown = errors.New("chanlog: exporter has been shut down")
+
+// Subscribe returns a new log record channel receiving all log records exported
+// from now on, as well as a function to unsubscribe again. Each subscriber gets
+// its own channel, so that multiple concurrent consumers do not steal log
+// records from each other.
+//
+// The subscriber channel is configured using the same options as for [New],
+// such as [WithCap], [WithChannel], [WithFilter], and [WithOverflow]. Please
+// note that a subscriber configured with the default [OverflowBlock] policy
+// blocks exporting log records to all other subscribers while its channel is
+// full, until either the export context is done or the subscriber gets
+// unsubscribed. [WithBroadcast] is ignored.
+//
+// Unsubscribing closes the subscriber channel; it is safe to unsubscribe
+// multiple times. Shutting down the exporter closes all subscriber channels.
+func (e *Exporter) Subscribe(opts ...Option) (RecordsChannel, func(), error) {
+	opts = append(opts, func(o *options) { o.broadcast = false })
+	sub, err := New(opts...)
+	if err != nil {
+		return nil, nil, err
+	}
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.shut {
+		return nil, nil, ErrShutdown
+	}
+	if e.subs == nil {
+		e.subs = map[*Exporter]struct{}{}
+	}
+	e.subs[sub] = struct{}{}
+	return sub.Ch(), func() { e.unsubscribe(sub) }, nil
+}
+
+// unsubscribe removes the passed subscriber and closes its channel, unless it
+// has already been removed.
+func (e *Exporter) unsubscribe(sub *Exporter) {
+	e.mu.Lock()
+	if _, ok := e.subs[sub]; !ok {
+		e.mu.Unlock()
+		return
+	}
+	delete(e.subs, sub)
+	e.mu.Unlock()
+	// shut down outside the lock, interrupting any export blocked on the
+	// subscriber's full channel.
+	_ = sub.Shutdown(context.Background())
+}
@@ -0,0 +1,178 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package chanlog
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/sdk/log/logtest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/thediveo/success"
+)
+
+var _ = Describe("subscribing to an OTel log record channel exporter", func() {
+
+	newRecord := func(i int) sdklog.Record {
+		r := logtest.RecordFactory{}.NewRecord()
+		r.SetBody(log.IntValue(i))
+		return r
+	}
+
+	It("has no channel of its own in broadcast mode", func(ctx context.Context) {
+		e := Successful(New(WithBroadcast()))
+		Expect(e.Ch()).To(BeNil())
+		Expect(e.Export(ctx, []sdklog.Record{newRecord(1)})).To(Succeed())
+		Expect(e.Shutdown(ctx)).To(Succeed())
+	})
+
+	It("fans out log records to all subscribers", func(ctx context.Context) {
+		e := Successful(New(WithBroadcast()))
+		ch1, unsubscribe1, err := e.Subscribe(WithCap(10))
+		Expect(err).NotTo(HaveOccurred())
+		defer unsubscribe1()
+		ch2, unsubscribe2, err := e.Subscribe(WithCap(10),
+			WithFilter(func(r sdklog.Record) bool { return r.Body().AsInt64() > 1 }))
+		Expect(err).NotTo(HaveOccurred())
+		defer unsubscribe2()
+
+		Expect(e.Export(ctx, []sdklog.Record{newRecord(1), newRecord(2)})).To(Succeed())
+		Expect(ch1).To(Receive(HaveField("Body()", log.IntValue(1))))
+		Expect(ch1).To(Receive(HaveField("Body()", log.IntValue(2))))
+		Expect(ch2).To(Receive(HaveField("Body()", log.IntValue(2))))
+		Expect(ch2).To(BeEmpty())
+	})
+
+	It("exports to both its own channel and subscribers", func(ctx context.Context) {
+		e := Successful(New(WithCap(10)))
+		ch, unsubscribe, err := e.Subscribe(WithCap(10))
+		Expect(err).NotTo(HaveOccurred())
+		defer unsubscribe()
+		Expect(e.Export(ctx, []sdklog.Record{newRecord(1)})).To(Succeed())
+		Expect(e.Ch()).To(HaveLen(1))
+		Expect(ch).To(HaveLen(1))
+	})
+
+	It("unsubscribes", func(ctx context.Context) {
+		e := Successful(New(WithBroadcast()))
+		ch, unsubscribe, err := e.Subscribe(WithCap(10))
+		Expect(err).NotTo(HaveOccurred())
+		unsubscribe()
+		unsubscribe()
+		Expect(ch).To(BeClosed())
+		Expect(e.Export(ctx, []sdklog.Record{newRecord(1)})).To(Succeed())
+	})
+
+	It("closes all subscriber channels on shutdown", func(ctx context.Context) {
+		e := Successful(New(WithBroadcast()))
+		ch1, unsubscribe1, err := e.Subscribe()
+		Expect(err).NotTo(HaveOccurred())
+		ch2, _, err := e.Subscribe()
+		Expect(err).NotTo(HaveOccurred())
+		Expect(e.Shutdown(ctx)).To(Succeed())
+		Expect(ch1).To(BeClosed())
+		Expect(ch2).To(BeClosed())
+		unsubscribe1()
+
+		Expect(e.Subscribe()).Error().To(MatchError(ErrShutdown))
+	})
+
+	It("rejects invalid subscriber options", func() {
+		e := Successful(New(WithBroadcast()))
+		Expect(e.Subscribe(WithFilter(42))).Error().To(HaveOccurred())
+	})
+
+	It("reports subscriber overflows", func(ctx context.Context) {
+		e := Successful(New(WithBroadcast()))
+		_, unsubscribe, err := e.Subscribe(WithOverflow(OverflowFail))
+		Expect(err).NotTo(HaveOccurred())
+		defer unsubscribe()
+		Expect(e.Export(ctx, []sdklog.Record{newRecord(1), newRecord(2)})).To(
+			MatchError(ErrOverflow))
+	})
+
+	When("an export is blocked on a full subscriber channel", func() {
+
+		// exportBlocked exports three records to the passed exporter, where the
+		// second one blocks on a channel with capacity 1, returning a channel
+		// that receives the export's result when the export has finished.
+		exportBlocked := func(e *Exporter, ch RecordsChannel) chan error {
+			exported := make(chan error, 1)
+			go func() {
+				exported <- e.Export(context.Background(),
+					[]sdklog.Record{newRecord(1), newRecord(2), newRecord(3)})
+			}()
+			Eventually(ch).Should(HaveLen(1))
+			Consistently(exported).WithTimeout(50 * time.Millisecond).ShouldNot(Receive())
+			return exported
+		}
+
+		It("unsubscribes without deadlocking", func() {
+			e := Successful(New(WithBroadcast()))
+			ch, unsubscribe, err := e.Subscribe(WithCap(1))
+			Expect(err).NotTo(HaveOccurred())
+			exported := exportBlocked(e, ch)
+
+			unsubscribed := make(chan struct{})
+			go func() {
+				defer close(unsubscribed)
+				unsubscribe()
+			}()
+			Eventually(unsubscribed).Within(2 * time.Second).Should(BeClosed())
+			Eventually(exported).Within(2 * time.Second).Should(Receive(
+				And(MatchError(ErrShutdown), MatchError(ContainSubstring("dropped 2 log records")))))
+			Expect(ch).To(Receive(HaveField("Body()", log.IntValue(1))))
+			Expect(ch).To(BeClosed())
+
+			Expect(e.Export(context.Background(), []sdklog.Record{newRecord(4)})).To(Succeed())
+		})
+
+		It("shuts down without deadlocking", func() {
+			e := Successful(New(WithBroadcast()))
+			ch, unsubscribe, err := e.Subscribe(WithCap(1))
+			Expect(err).NotTo(HaveOccurred())
+			defer unsubscribe()
+			exported := exportBlocked(e, ch)
+
+			shutdown := make(chan struct{})
+			go func() {
+				defer close(shutdown)
+				Expect(e.Shutdown(context.Background())).To(Succeed())
+			}()
+			Eventually(shutdown).Within(2 * time.Second).Should(BeClosed())
+			Eventually(exported).Within(2 * time.Second).Should(Receive(MatchError(ErrShutdown)))
+			Expect(ch).To(Receive())
+			Expect(ch).To(BeClosed())
+		})
+
+		It("counts the records lost when shutting down an exporter blocked on its own channel", func() {
+			e := Successful(New(WithCap(1)))
+			ch := e.Ch()
+			exported := exportBlocked(e, ch)
+
+			Expect(e.Shutdown(context.Background())).To(Succeed())
+			Eventually(exported).Within(2 * time.Second).Should(Receive(MatchError(ErrShutdown)))
+			Expect(e.Dropped()).To(Equal(uint64(2)))
+			Expect(ch).To(Receive(HaveField("Body()", log.IntValue(1))))
+			Expect(ch).To(BeClosed())
+		})
+
+	})
+
+})
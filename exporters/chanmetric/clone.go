@@ -0,0 +1,126 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package chanmetric
+
+import (
+	"slices"
+
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// cloneResourceMetrics returns a deep copy of the passed resource metrics, as
+// the OTel metric SDK reuses the resource metrics passed to exporters after
+// the export has returned.
+func cloneResourceMetrics(rm *metricdata.ResourceMetrics) metricdata.ResourceMetrics {
+	clone := metricdata.ResourceMetrics{
+		Resource:     rm.Resource, // immutable
+		ScopeMetrics: make([]metricdata.ScopeMetrics, 0, len(rm.ScopeMetrics)),
+	}
+	for _, sm := range rm.ScopeMetrics {
+		ms := make([]metricdata.Metrics, 0, len(sm.Metrics))
+		for _, m := range sm.Metrics {
+			m.Data = cloneAggregation(m.Data)
+			ms = append(ms, m)
+		}
+		clone.ScopeMetrics = append(clone.ScopeMetrics, metricdata.ScopeMetrics{
+			Scope:   sm.Scope, // attribute sets are immutable
+			Metrics: ms,
+		})
+	}
+	return clone
+}
+
+// cloneAggregation returns a deep copy of the passed metric aggregation data.
+func cloneAggregation(data metricdata.Aggregation) metricdata.Aggregation {
+	switch data := data.(type) {
+	case metricdata.Gauge[int64]:
+		data.DataPoints = cloneDataPoints(data.DataPoints)
+		return data
+	case metricdata.Gauge[float64]:
+		data.DataPoints = cloneDataPoints(data.DataPoints)
+		return data
+	case metricdata.Sum[int64]:
+		data.DataPoints = cloneDataPoints(data.DataPoints)
+		return data
+	case metricdata.Sum[float64]:
+		data.DataPoints = cloneDataPoints(data.DataPoints)
+		return data
+	case metricdata.Histogram[int64]:
+		data.DataPoints = cloneHistogramDataPoints(data.DataPoints)
+		return data
+	case metricdata.Histogram[float64]:
+		data.DataPoints = cloneHistogramDataPoints(data.DataPoints)
+		return data
+	case metricdata.ExponentialHistogram[int64]:
+		data.DataPoints = cloneExponentialHistogramDataPoints(data.DataPoints)
+		return data
+	case metricdata.ExponentialHistogram[float64]:
+		data.DataPoints = cloneExponentialHistogramDataPoints(data.DataPoints)
+		return data
+	case metricdata.Summary:
+		data.DataPoints = cloneSummaryDataPoints(data.DataPoints)
+		return data
+	}
+	return data
+}
+
+func cloneDataPoints[N int64 | float64](dps []metricdata.DataPoint[N]) []metricdata.DataPoint[N] {
+	dps = slices.Clone(dps)
+	for idx := range dps {
+		dps[idx].Exemplars = cloneExemplars(dps[idx].Exemplars)
+	}
+	return dps
+}
+
+func cloneHistogramDataPoints[N int64 | float64](dps []metricdata.HistogramDataPoint[N]) []metricdata.HistogramDataPoint[N] {
+	dps = slices.Clone(dps)
+	for idx := range dps {
+		dp := &dps[idx]
+		dp.Bounds = slices.Clone(dp.Bounds)
+		dp.BucketCounts = slices.Clone(dp.BucketCounts)
+		dp.Exemplars = cloneExemplars(dp.Exemplars)
+	}
+	return dps
+}
+
+func cloneExponentialHistogramDataPoints[N int64 | float64](dps []metricdata.ExponentialHistogramDataPoint[N]) []metricdata.ExponentialHistogramDataPoint[N] {
+	dps = slices.Clone(dps)
+	for idx := range dps {
+		dp := &dps[idx]
+		dp.PositiveBucket.Counts = slices.Clone(dp.PositiveBucket.Counts)
+		dp.NegativeBucket.Counts = slices.Clone(dp.NegativeBucket.Counts)
+		dp.Exemplars = cloneExemplars(dp.Exemplars)
+	}
+	return dps
+}
+
+func cloneSummaryDataPoints(dps []metricdata.SummaryDataPoint) []metricdata.SummaryDataPoint {
+	dps = slices.Clone(dps)
+	for idx := range dps {
+		dps[idx].QuantileValues = slices.Clone(dps[idx].QuantileValues)
+	}
+	return dps
+}
+
+func cloneExemplars[N int64 | float64](exs []metricdata.Exemplar[N]) []metricdata.Exemplar[N] {
+	exs = slices.Clone(exs)
+	for idx := range exs {
+		ex := &exs[idx]
+		ex.FilteredAttributes = slices.Clone(ex.FilteredAttributes)
+		ex.SpanID = slices.Clone(ex.SpanID)
+		ex.TraceID = slices.Clone(ex.TraceID)
+	}
+	return exs
+}
@@ -0,0 +1,16 @@
+/*
+Package chanmetric provides an exporter as well as a reader for OpenTelemetry
+metric telemetry that send resource metrics snapshots into a Go channel.
+
+This exporter and reader are intended to be used for testing, they are not
+meant for production use.
+
+Use an [Exporter] in combination with an [sdkmetric.PeriodicReader] to receive
+resource metrics snapshots on a periodic schedule as well as on flushing the
+periodic reader. Alternatively, use a [Reader] to receive a resource metrics
+snapshot whenever the test code calls [Reader.Collect].
+
+Tests then can pick up the resource metrics from the Go channel, either
+concurrently or at certain check points, leveraging channel buffering.
+*/
+package chanmetric
@@ -0,0 +1,55 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package chanmetric_test
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+
+	"github.com/onsi/gomega"
+
+	"github.com/thediveo/otelcheck/exporters/chanmetric"
+	. "github.com/thediveo/otelcheck/motel"
+)
+
+func Example() {
+	/* only in testable example */ Ω := gomega.NewGomega(func(message string, _ ...int) { panic(message) })
+
+	ctx := context.TODO()
+
+	// create a periodic reader that exports resource metrics snapshots into a
+	// buffered channel with a capacity for 10 snapshots.
+	exporter, _ := chanmetric.New(chanmetric.WithCap(10))
+	reader := sdkmetric.NewPeriodicReader(exporter, sdkmetric.WithInterval(100*time.Millisecond))
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	defer func() { _ = provider.Shutdown(ctx) }()
+
+	// get the channel the snapshots are sent to now, as the exporter's Ch()
+	// will return a nil channel as soon as Shutdown has been called on the
+	// exporter.
+	ch := exporter.Ch()
+
+	counter, _ := provider.Meter("example").Int64Counter("foo.requests")
+	counter.Add(ctx, 42, metric.WithAttributes(attribute.String("method", "GET")))
+
+	Ω.Eventually(ch).Should(gomega.Receive(HaveMetric(
+		HaveName("foo.requests"),
+		HaveDataPoint(HaveAttribute("method=GET"), HaveDataPointValue(42)))))
+	// Output:
+}
@@ -0,0 +1,126 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package chanmetric
+
+import (
+	"context"
+	"sync/atomic"
+
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// ResourceMetricsChannel channels OTel SDK resource metrics snapshots.
+//
+// Please note that the channel passes resource metrics by value, not by
+// reference. Each resource metrics value is a deep copy, so it is safe to
+// retain it.
+type ResourceMetricsChannel chan metricdata.ResourceMetrics
+
+// Exporter writes resource metrics to a Go channel of type
+// [ResourceMetricsChannel] (chan of [metricdata.ResourceMetrics]). Use [New] to
+// create an Exporter.
+type Exporter struct {
+	ch          atomic.Pointer[ResourceMetricsChannel]
+	temporality sdkmetric.TemporalitySelector
+	aggregation sdkmetric.AggregationSelector
+}
+
+// statically ensure that we fulfill the OTel metric SDK's Exporter interface.
+var _ (sdkmetric.Exporter) = (*Exporter)(nil)
+
+// New returns a new resource metrics exporter, configured with the passed
+// options.
+//
+// If no resource metrics channel has been explicitly configured using
+// [WithChannel], a suitable channel will be implicitly created and can later be
+// retrieved using [Exporter.Ch]. Please note that the minimum configurable
+// buffer size of an implicitly created channel is 1.
+func New(opts ...Option) (*Exporter, error) {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	if o.ch == nil {
+		o.ch = make(ResourceMetricsChannel, max(o.capacity, 1))
+	}
+	if o.temporality == nil {
+		o.temporality = sdkmetric.DefaultTemporalitySelector
+	}
+	if o.aggregation == nil {
+		o.aggregation = sdkmetric.DefaultAggregationSelector
+	}
+
+	e := &Exporter{
+		temporality: o.temporality,
+		aggregation: o.aggregation,
+	}
+	ch := o.ch
+	e.ch.Store(&ch)
+	return e, nil
+}
+
+// Ch returns the resource metrics channel, or nil after [Exporter.Shutdown] has
+// been called.
+func (e *Exporter) Ch() ResourceMetricsChannel {
+	ch := e.ch.Load()
+	if ch == nil {
+		return nil
+	}
+	return *ch
+}
+
+// Temporality returns the temporality to use for the specified instrument
+// kind.
+func (e *Exporter) Temporality(kind sdkmetric.InstrumentKind) metricdata.Temporality {
+	return e.temporality(kind)
+}
+
+// Aggregation returns the aggregation to use for the specified instrument
+// kind.
+func (e *Exporter) Aggregation(kind sdkmetric.InstrumentKind) sdkmetric.Aggregation {
+	return e.aggregation(kind)
+}
+
+// Export a deep copy of the passed resource metrics to the configured channel.
+// It does nothing after [Exporter.Shutdown] has been called.
+func (e *Exporter) Export(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+	ch := e.ch.Load()
+	if ch == nil {
+		return ctx.Err()
+	}
+
+	select {
+	case *ch <- cloneResourceMetrics(rm):
+	case <-ctx.Done():
+	}
+	return ctx.Err()
+}
+
+// Shutdown the Exporter so that any later calls to [Exporter.Export] will
+// perform no operation anymore and additionally closes the writing end of the
+// exporter's resource metrics channel.
+func (e *Exporter) Shutdown(context.Context) error {
+	ch := e.ch.Swap(nil)
+	if ch == nil {
+		return nil
+	}
+	close(*ch)
+	return nil
+}
+
+// ForceFlush is a no-op.
+func (*Exporter) ForceFlush(context.Context) error { return nil }
@@ -0,0 +1,143 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package chanmetric
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/thediveo/success"
+)
+
+var _ = Describe("OTel resource metrics channel exporter", func() {
+
+	newResourceMetrics := func(value int64) *metricdata.ResourceMetrics {
+		return &metricdata.ResourceMetrics{
+			ScopeMetrics: []metricdata.ScopeMetrics{
+				{
+					Metrics: []metricdata.Metrics{
+						{
+							Name: "foo",
+							Data: metricdata.Gauge[int64]{
+								DataPoints: []metricdata.DataPoint[int64]{
+									{
+										Attributes: attribute.NewSet(attribute.String("foo", "bar")),
+										Value:      value,
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+		}
+	}
+
+	It("returns a channel exporter with an implicitly created channel", func() {
+		e := Successful(New())
+		ch := e.Ch()
+		Expect(ch).NotTo(BeNil())
+		Expect(ch).To(HaveCap(1))
+	})
+
+	It("correctly configures the channel size", func() {
+		const size = 42
+		e := Successful(New(WithCap(size)))
+		Expect(e.Ch()).To(HaveCap(size))
+		Expect(Successful(New(WithCap(0))).Ch()).To(HaveCap(1))
+	})
+
+	It("uses the default selectors unless configured otherwise", func() {
+		e := Successful(New())
+		Expect(e.Temporality(sdkmetric.InstrumentKindCounter)).To(Equal(metricdata.CumulativeTemporality))
+		Expect(e.Aggregation(sdkmetric.InstrumentKindCounter)).To(Equal(sdkmetric.AggregationSum{}))
+
+		e = Successful(New(
+			WithTemporalitySelector(func(sdkmetric.InstrumentKind) metricdata.Temporality {
+				return metricdata.DeltaTemporality
+			}),
+			WithAggregationSelector(func(sdkmetric.InstrumentKind) sdkmetric.Aggregation {
+				return sdkmetric.AggregationDrop{}
+			})))
+		Expect(e.Temporality(sdkmetric.InstrumentKindCounter)).To(Equal(metricdata.DeltaTemporality))
+		Expect(e.Aggregation(sdkmetric.InstrumentKindCounter)).To(Equal(sdkmetric.AggregationDrop{}))
+	})
+
+	It("closes the channel upon shutdown", func(ctx context.Context) {
+		e := Successful(New())
+		ch := e.Ch()
+		Expect(e.Shutdown(ctx)).To(Succeed())
+		Expect(e.Shutdown(ctx)).To(Succeed(), "must be idempotent")
+		Expect(ch).To(BeClosed())
+		Expect(e.Ch()).To(BeNil())
+	})
+
+	It("flushes (not really)", func(ctx context.Context) {
+		Expect(Successful(New()).ForceFlush(ctx)).To(Succeed())
+	})
+
+	It("exports deep copies of resource metrics to the buffered channel", func(ctx context.Context) {
+		ch := make(ResourceMetricsChannel, 2)
+		e := Successful(New(WithChannel(ch)))
+		Expect(e.Ch()).To(HaveCap(2))
+
+		rm := newResourceMetrics(42)
+		Expect(e.Export(ctx, rm)).To(Succeed())
+		// modify the original data point after the export; the exported copy
+		// must not be affected.
+		rm.ScopeMetrics[0].Metrics[0].Data.(metricdata.Gauge[int64]).DataPoints[0].Value = 666
+
+		var exported metricdata.ResourceMetrics
+		Eventually(ch).Within(2 * time.Second).Should(Receive(&exported))
+		Expect(exported.ScopeMetrics[0].Metrics[0].Data.(metricdata.Gauge[int64]).DataPoints[0].Value).
+			To(Equal(int64(42)))
+	})
+
+	It("doesn't export anymore after shutdown", func() {
+		e := Successful(New())
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		Expect(e.Shutdown(ctx)).To(Succeed())
+		Expect(e.Export(ctx, newResourceMetrics(42))).To(Succeed())
+		cancel()
+		Expect(e.Export(ctx, newResourceMetrics(42))).To(MatchError("context canceled"))
+	})
+
+	It("cancels exports", func(ctx context.Context) {
+		e := Successful(New(WithCap(1)))
+		ch := e.Ch()
+
+		exportCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		Expect(e.Export(exportCtx, newResourceMetrics(1))).To(Succeed())
+		done := make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			Expect(e.Export(exportCtx, newResourceMetrics(2))).To(MatchError("context canceled"))
+			close(done)
+		}()
+
+		Eventually(ch).Within(2 * time.Second).Should(HaveLen(1))
+		cancel()
+		Eventually(done).Should(BeClosed())
+	})
+
+})
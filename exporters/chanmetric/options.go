@@ -0,0 +1,64 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package chanmetric
+
+import (
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+)
+
+// Option configures a resource metrics channel [Exporter] or [Reader].
+type Option func(*options)
+
+type options struct {
+	capacity    int
+	ch          ResourceMetricsChannel
+	temporality sdkmetric.TemporalitySelector
+	aggregation sdkmetric.AggregationSelector
+}
+
+// WithCap configures the capacity of the implicit resource metrics channel,
+// unless an explicit resource metrics channel is configured using
+// [WithChannel]. The specified capacity is clamped to at least 1.
+func WithCap(capacity int) func(o *options) {
+	return func(o *options) {
+		o.capacity = max(capacity, 1)
+	}
+}
+
+// WithChannel configures an explicit resource metrics channel. Any [WithCap]
+// configuration is ignored.
+func WithChannel(ch ResourceMetricsChannel) func(o *options) {
+	return func(o *options) {
+		o.ch = ch
+	}
+}
+
+// WithTemporalitySelector configures the temporality to use for the different
+// instrument kinds. If not configured, [sdkmetric.DefaultTemporalitySelector]
+// is used.
+func WithTemporalitySelector(selector sdkmetric.TemporalitySelector) func(o *options) {
+	return func(o *options) {
+		o.temporality = selector
+	}
+}
+
+// WithAggregationSelector configures the aggregation to use for the different
+// instrument kinds. If not configured, [sdkmetric.DefaultAggregationSelector]
+// is used.
+func WithAggregationSelector(selector sdkmetric.AggregationSelector) func(o *options) {
+	return func(o *options) {
+		o.aggregation = selector
+	}
+}
@@ -0,0 +1,79 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package chanmetric
+
+import (
+	"context"
+
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+)
+
+// Reader is a [sdkmetric.ManualReader] that additionally sends a snapshot of
+// the collected resource metrics into a Go channel of type
+// [ResourceMetricsChannel] whenever [Reader.Collect] gets called. Use
+// [NewReader] to create a Reader.
+type Reader struct {
+	*sdkmetric.ManualReader
+	exp *Exporter
+}
+
+// statically ensure that we fulfill the OTel metric SDK's Reader interface.
+var _ (sdkmetric.Reader) = (*Reader)(nil)
+
+// NewReader returns a new resource metrics reader, configured with the passed
+// options.
+//
+// If no resource metrics channel has been explicitly configured using
+// [WithChannel], a suitable channel will be implicitly created and can later be
+// retrieved using [Reader.Ch]. Please note that the minimum configurable buffer
+// size of an implicitly created channel is 1.
+func NewReader(opts ...Option) (*Reader, error) {
+	exp, err := New(opts...)
+	if err != nil {
+		return nil, err
+	}
+	return &Reader{
+		ManualReader: sdkmetric.NewManualReader(
+			sdkmetric.WithTemporalitySelector(exp.temporality),
+			sdkmetric.WithAggregationSelector(exp.aggregation)),
+		exp: exp,
+	}, nil
+}
+
+// Ch returns the resource metrics channel, or nil after [Reader.Shutdown] has
+// been called.
+func (r *Reader) Ch() ResourceMetricsChannel {
+	return r.exp.Ch()
+}
+
+// Collect gathers all metric data from the SDK into the passed resource
+// metrics, as well as sending a deep copy of the collected resource metrics to
+// the configured channel.
+func (r *Reader) Collect(ctx context.Context, rm *metricdata.ResourceMetrics) error {
+	if err := r.ManualReader.Collect(ctx, rm); err != nil {
+		return err
+	}
+	return r.exp.Export(ctx, rm)
+}
+
+// Shutdown the Reader so that any later calls to [Reader.Collect] fail, and
+// additionally closes the writing end of the reader's resource metrics
+// channel.
+func (r *Reader) Shutdown(ctx context.Context) error {
+	err := r.ManualReader.Shutdown(ctx)
+	_ = r.exp.Shutdown(ctx)
+	return err
+}
@@ -0,0 +1,56 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package chanmetric
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/thediveo/success"
+)
+
+var _ = Describe("OTel resource metrics channel reader", func() {
+
+	It("sends collected resource metrics to the channel", func(ctx context.Context) {
+		r := Successful(NewReader(WithCap(2)))
+		ch := r.Ch()
+		Expect(ch).To(HaveCap(2))
+		mp := sdkmetric.NewMeterProvider(sdkmetric.WithReader(r))
+
+		counter := Successful(mp.Meter("test").Int64Counter("foo"))
+		counter.Add(ctx, 42, metric.WithAttributes(attribute.String("foo", "bar")))
+
+		var rm metricdata.ResourceMetrics
+		Expect(r.Collect(ctx, &rm)).To(Succeed())
+		Expect(rm.ScopeMetrics).To(HaveLen(1))
+
+		var collected metricdata.ResourceMetrics
+		Expect(ch).To(Receive(&collected))
+		Expect(collected.ScopeMetrics).To(ConsistOf(
+			HaveField("Metrics", ConsistOf(HaveField("Name", "foo")))))
+
+		Expect(mp.Shutdown(ctx)).To(Succeed())
+		Expect(ch).To(BeClosed())
+		Expect(r.Ch()).To(BeNil())
+		Expect(r.Collect(ctx, &rm)).NotTo(Succeed())
+	})
+
+})
@@ -0,0 +1,12 @@
+/*
+Package chanspan provides an exporter for OpenTelemetry trace telemetry that
+sends ended spans into a Go channel.
+
+This exporter is intended to be used for testing, it is not meant for production
+use.
+
+Tests then can pick up the spans ended by the code under test from the Go
+channel, either concurrently or at certain check points, leveraging channel
+buffering.
+*/
+package chanspan
@@ -0,0 +1,98 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package chanspan
+
+import (
+	"context"
+	"sync/atomic"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+)
+
+// SpansChannel channels ended OTel SDK spans in their read-only form of
+// [sdktrace.ReadOnlySpan].
+type SpansChannel chan sdktrace.ReadOnlySpan
+
+// Exporter writes ended spans to a Go channel of type [SpansChannel] (chan of
+// [sdktrace.ReadOnlySpan]). Use [New] to create an Exporter.
+type Exporter struct {
+	ch atomic.Pointer[SpansChannel]
+}
+
+// statically ensure that we fulfill the OTel tracing SDK's SpanExporter
+// interface.
+var _ (sdktrace.SpanExporter) = (*Exporter)(nil)
+
+// New returns a new span exporter, configured with the passed options.
+//
+// If no span channel has been explicitly configured using [WithChannel], a
+// suitable channel will be implicitly created and can later be retrieved using
+// [Exporter.Ch]. Please note that the minimum configurable buffer size of an
+// implicitly created channel is 1.
+func New(opts ...Option) (*Exporter, error) {
+	var o options
+	for _, opt := range opts {
+		opt(&o)
+	}
+
+	if o.ch == nil {
+		o.ch = make(SpansChannel, max(o.capacity, 1))
+	}
+
+	e := &Exporter{}
+	ch := o.ch
+	e.ch.Store(&ch)
+	return e, nil
+}
+
+// Ch returns the span channel, or nil after [Exporter.Shutdown] has been
+// called.
+func (e *Exporter) Ch() SpansChannel {
+	ch := e.ch.Load()
+	if ch == nil {
+		return nil
+	}
+	return *ch
+}
+
+// ExportSpans exports the ended spans to the configured channel. It does
+// nothing after [Exporter.Shutdown] has been called.
+func (e *Exporter) ExportSpans(ctx context.Context, spans []sdktrace.ReadOnlySpan) error {
+	ch := e.ch.Load()
+	if ch == nil {
+		return ctx.Err()
+	}
+
+	for _, span := range spans {
+		select {
+		case *ch <- span:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+	}
+	return ctx.Err()
+}
+
+// Shutdown the Exporter so that any later calls to [Exporter.ExportSpans] will
+// perform no operation anymore and additionally closes the writing end of the
+// exporter's span channel.
+func (e *Exporter) Shutdown(context.Context) error {
+	ch := e.ch.Swap(nil)
+	if ch == nil {
+		return nil
+	}
+	close(*ch)
+	return nil
+}
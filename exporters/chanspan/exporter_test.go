@@ -0,0 +1,111 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package chanspan
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/thediveo/success"
+)
+
+var _ = Describe("OTel span channel exporter", func() {
+
+	newSpan := func(name string) sdktrace.ReadOnlySpan {
+		return tracetest.SpanStub{Name: name}.Snapshot()
+	}
+
+	It("returns a channel exporter with an implicitly created span channel", func() {
+		e := Successful(New())
+		ch := e.Ch()
+		Expect(ch).NotTo(BeNil())
+		Expect(ch).To(HaveCap(1))
+	})
+
+	It("correctly configures the channel size", func() {
+		const size = 42
+		Expect(Successful(New(WithCap(size))).Ch()).To(HaveCap(size))
+		Expect(Successful(New(WithCap(0))).Ch()).To(HaveCap(1))
+	})
+
+	It("closes the channel upon shutdown", func(ctx context.Context) {
+		e := Successful(New())
+		ch := e.Ch()
+		Expect(e.Shutdown(ctx)).To(Succeed())
+		Expect(e.Shutdown(ctx)).To(Succeed(), "must be idempotent")
+		Expect(ch).To(BeClosed())
+		Expect(e.Ch()).To(BeNil())
+	})
+
+	It("exports spans to the buffered channel", func(ctx context.Context) {
+		ch := make(SpansChannel, 2)
+		e := Successful(New(WithChannel(ch)))
+		Expect(e.Ch()).To(HaveCap(2))
+
+		done := make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			for i := range 10 {
+				Expect(e.ExportSpans(ctx, []sdktrace.ReadOnlySpan{newSpan(fmt.Sprintf("span-%d", i))})).
+					To(Succeed())
+			}
+			close(done)
+		}()
+
+		for i := range 10 {
+			Eventually(ctx, ch).Within(2 * time.Second).Should(Receive(
+				HaveField("Name()", fmt.Sprintf("span-%d", i))))
+		}
+		Eventually(done).Should(BeClosed())
+	})
+
+	It("doesn't export anymore after shutdown", func() {
+		e := Successful(New())
+		ctx, cancel := context.WithCancel(context.Background())
+		defer cancel()
+		Expect(e.Shutdown(ctx)).To(Succeed())
+		Expect(e.ExportSpans(ctx, []sdktrace.ReadOnlySpan{newSpan("foo")})).To(Succeed())
+		cancel()
+		Expect(e.ExportSpans(ctx, []sdktrace.ReadOnlySpan{newSpan("foo")})).To(MatchError("context canceled"))
+	})
+
+	It("cancels exports", func(ctx context.Context) {
+		e := Successful(New(WithCap(1)))
+		ch := e.Ch()
+
+		exportCtx, cancel := context.WithCancel(ctx)
+		defer cancel()
+		done := make(chan struct{})
+		go func() {
+			defer GinkgoRecover()
+			Expect(e.ExportSpans(exportCtx, []sdktrace.ReadOnlySpan{
+				newSpan("foo"),
+				newSpan("bar"),
+			})).To(MatchError("context canceled"))
+			close(done)
+		}()
+
+		Eventually(ch).Within(2 * time.Second).Should(HaveLen(1))
+		cancel()
+		Eventually(done).Should(BeClosed())
+	})
+
+})
@@ -0,0 +1,26 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+/*
+Package memlog provides an exporter for OpenTelemetry log telemetry that keeps
+all exported log records in memory.
+
+This exporter is intended to be used for testing, it is not meant for production
+use.
+
+In contrast to the [github.com/thediveo/otelcheck/exporters/chanlog] exporter,
+tests can inspect the log record history as often as they like, for instance,
+to assert that a particular log record has never been emitted.
+*/
+package memlog
@@ -0,0 +1,50 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package memlog_test
+
+import (
+	"context"
+	"fmt"
+
+	"go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+
+	"github.com/thediveo/otelcheck/exporters/memlog"
+	"github.com/thediveo/otelcheck/lotel"
+
+	"github.com/onsi/gomega"
+)
+
+func Example() {
+	/* only in testable example */ Ω := gomega.NewGomega(func(message string, _ ...int) { panic(message) })
+	ctx := context.Background()
+
+	store := memlog.New()
+	provider := sdklog.NewLoggerProvider(
+		sdklog.WithProcessor(sdklog.NewSimpleProcessor(store)))
+	defer func() { _ = provider.Shutdown(ctx) }()
+	logger := provider.Logger("example")
+
+	r := log.Record{}
+	r.SetEventName("org.foo")
+	logger.Emit(ctx, r)
+
+	Ω.Eventually(store.Records).Should(gomega.ContainElement(
+		lotel.BeARecord(lotel.HaveEventName("org.foo"))))
+	// the store doesn't consume the records, so we can check again...
+	Ω.Expect(store.Filter(lotel.BeARecord(lotel.HaveEventName("org.bar")))).To(gomega.BeEmpty())
+	fmt.Println(store.Len())
+	// Output: 1
+}
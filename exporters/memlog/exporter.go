@@ -0,0 +1,117 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package memlog
+
+import (
+	"context"
+	"slices"
+	"sync"
+
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+
+	ty "github.com/onsi/gomega/types"
+)
+
+// Exporter keeps all exported log records in memory. It is safe for concurrent
+// use. Use [New] to create an Exporter.
+type Exporter struct {
+	mu       sync.Mutex
+	records  []sdklog.Record
+	shutdown bool
+}
+
+// statically ensure that we fulfill the OTel logging SDK's Exporter interface.
+var _ (sdklog.Exporter) = (*Exporter)(nil)
+
+// New returns a new in-memory log record exporter.
+func New() *Exporter {
+	return &Exporter{}
+}
+
+// Export log records to the in-memory store. It does nothing after
+// [Exporter.Shutdown] has been called.
+func (e *Exporter) Export(ctx context.Context, records []sdklog.Record) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	if e.shutdown {
+		return ctx.Err()
+	}
+	for _, rec := range records {
+		e.records = append(e.records, rec.Clone())
+	}
+	return ctx.Err()
+}
+
+// Records returns a snapshot of all log records exported so far, in the order
+// they were exported. As the returned slice is a snapshot, it is not affected
+// by further exports.
+//
+// Records can be directly used with asynchronous assertions:
+//
+//	Eventually(store.Records).Should(ContainElement(BeARecord(...)))
+func (e *Exporter) Records() []sdklog.Record {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return slices.Clone(e.records)
+}
+
+// Len returns the number of log records exported so far.
+func (e *Exporter) Len() int {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	return len(e.records)
+}
+
+// Reset discards all log records exported so far.
+func (e *Exporter) Reset() {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.records = nil
+}
+
+// Filter returns a snapshot of only those log records exported so far that are
+// matched by the passed matcher, such as a
+// [github.com/thediveo/otelcheck/lotel.BeARecord] matcher. If the matcher
+// returns an error on any record, Filter returns this error.
+//
+// As Gomega assertions check that any additional return values are nil or
+// zero, Filter can be directly used in assertions:
+//
+//	Expect(store.Filter(BeARecord(HaveSeverity(log.SeverityError)))).To(BeEmpty())
+func (e *Exporter) Filter(m ty.GomegaMatcher) ([]sdklog.Record, error) {
+	var records []sdklog.Record
+	for _, rec := range e.Records() {
+		success, err := m.Match(rec)
+		if err != nil {
+			return nil, err
+		}
+		if success {
+			records = append(records, rec)
+		}
+	}
+	return records, nil
+}
+
+// Shutdown the Exporter so that any later calls to [Exporter.Export] will
+// perform no operation anymore. The log records exported so far are kept.
+func (e *Exporter) Shutdown(context.Context) error {
+	e.mu.Lock()
+	defer e.mu.Unlock()
+	e.shutdown = true
+	return nil
+}
+
+// ForceFlush is a no-op.
+func (*Exporter) ForceFlush(context.Context) error { return nil }
@@ -0,0 +1,116 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package memlog
+
+import (
+	"context"
+	"sync"
+
+	"go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/sdk/log/logtest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/gcustom"
+)
+
+var _ = Describe("OTel in-memory log record exporter", func() {
+
+	rf := logtest.RecordFactory{
+		EventName: "foo",
+	}
+
+	recordWithBody := func(i int) sdklog.Record {
+		r := rf.NewRecord()
+		r.SetBody(log.IntValue(i))
+		return r
+	}
+
+	It("flushes (not really)", func(ctx context.Context) {
+		Expect(New().ForceFlush(ctx)).To(Succeed())
+	})
+
+	It("stores exported log records", func(ctx context.Context) {
+		e := New()
+		Expect(e.Len()).To(BeZero())
+		Expect(e.Records()).To(BeEmpty())
+
+		Expect(e.Export(ctx, []sdklog.Record{recordWithBody(1), recordWithBody(2)})).To(Succeed())
+		Expect(e.Export(ctx, []sdklog.Record{recordWithBody(3)})).To(Succeed())
+		Expect(e.Len()).To(Equal(3))
+		Expect(e.Records()).To(HaveEach(HaveField("EventName()", "foo")))
+		Expect(e.Records()).To(HaveExactElements(
+			HaveField("Body()", log.IntValue(1)),
+			HaveField("Body()", log.IntValue(2)),
+			HaveField("Body()", log.IntValue(3))))
+		Expect(e.Records()).To(HaveLen(3), "must not consume records")
+	})
+
+	It("returns snapshots", func(ctx context.Context) {
+		e := New()
+		Expect(e.Export(ctx, []sdklog.Record{recordWithBody(1)})).To(Succeed())
+		snapshot := e.Records()
+		Expect(e.Export(ctx, []sdklog.Record{recordWithBody(2)})).To(Succeed())
+		Expect(snapshot).To(HaveLen(1))
+	})
+
+	It("resets", func(ctx context.Context) {
+		e := New()
+		Expect(e.Export(ctx, []sdklog.Record{recordWithBody(1)})).To(Succeed())
+		e.Reset()
+		Expect(e.Len()).To(BeZero())
+		Expect(e.Records()).To(BeEmpty())
+	})
+
+	It("filters", func(ctx context.Context) {
+		e := New()
+		Expect(e.Export(ctx, []sdklog.Record{
+			recordWithBody(1), recordWithBody(2), recordWithBody(3),
+		})).To(Succeed())
+		Expect(e.Filter(HaveField("Body()", log.IntValue(2)))).To(HaveExactElements(
+			HaveField("Body()", log.IntValue(2))))
+		Expect(e.Filter(HaveField("Body()", log.IntValue(42)))).To(BeEmpty())
+		Expect(e.Filter(gcustom.MakeMatcher(func(sdklog.Record) (bool, error) {
+			return false, context.Canceled
+		}))).Error().To(MatchError(context.Canceled))
+	})
+
+	It("doesn't store anymore after shutdown", func(ctx context.Context) {
+		e := New()
+		Expect(e.Export(ctx, []sdklog.Record{recordWithBody(1)})).To(Succeed())
+		Expect(e.Shutdown(ctx)).To(Succeed())
+		Expect(e.Shutdown(ctx)).To(Succeed(), "must be idempotent")
+		Expect(e.Export(ctx, []sdklog.Record{recordWithBody(2)})).To(Succeed())
+		Expect(e.Len()).To(Equal(1))
+	})
+
+	It("is safe for concurrent use", func(ctx context.Context) {
+		e := New()
+		var wg sync.WaitGroup
+		for i := range 10 {
+			wg.Add(1)
+			go func() {
+				defer GinkgoRecover()
+				defer wg.Done()
+				Expect(e.Export(ctx, []sdklog.Record{recordWithBody(i)})).To(Succeed())
+				_ = e.Records()
+			}()
+		}
+		Eventually(e.Records).Should(HaveLen(10))
+		wg.Wait()
+	})
+
+})
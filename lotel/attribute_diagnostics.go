@@ -0,0 +1,164 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package lotel
+
+import (
+	"cmp"
+	"fmt"
+	"slices"
+	"strconv"
+
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+
+	"github.com/thediveo/otelcheck/lotel/logconv"
+
+	"github.com/onsi/gomega/format"
+)
+
+// maxCandidates is the maximum number of closest candidate attributes listed
+// for an unmatched attribute expectation.
+const maxCandidates = 3
+
+// levelAttribute is an attribute found at a particular level of a log record.
+type levelAttribute struct {
+	level attributeLevel
+	key   string
+	value any
+}
+
+// recordAttributes returns the attributes of the passed log record at all
+// levels, in resource, scope, and record level order.
+func recordAttributes(r *sdklog.Record) []levelAttribute {
+	var attrs []levelAttribute
+	it := r.Resource().Set().Iter()
+	for it.Next() {
+		attr := it.Attribute()
+		attrs = append(attrs, levelAttribute{
+			level: resourceLevel,
+			key:   string(attr.Key),
+			value: logconv.Any(logconv.FromAttribute(attr.Value)),
+		})
+	}
+	scopeAttrs := r.InstrumentationScope().Attributes
+	it = scopeAttrs.Iter()
+	for it.Next() {
+		attr := it.Attribute()
+		attrs = append(attrs, levelAttribute{
+			level: scopeLevel,
+			key:   string(attr.Key),
+			value: logconv.Any(logconv.FromAttribute(attr.Value)),
+		})
+	}
+	for attr := range r.WalkAttributes {
+		attrs = append(attrs, levelAttribute{
+			level: recordLevel,
+			key:   attr.Key,
+			value: logconv.Any(attr.Value),
+		})
+	}
+	return attrs
+}
+
+// describeUnmatchedAttribute describes the unmatched attribute expectation of
+// the passed attribute matcher, together with the closest candidate attributes
+// present on the passed log record.
+func describeUnmatchedAttribute(r *sdklog.Record, am attributeMatcher) string {
+	hm, ok := am.(*HaveAttributeMatcher)
+	if !ok {
+		return format.Object(am, 0)
+	}
+	desc := hm.expected()
+	if hm.negate {
+		return desc + describePresentAttributes(r, hm)
+	}
+	name, ok := hm.name.(string)
+	if !ok || hm.keyPattern {
+		return desc
+	}
+	type candidate struct {
+		levelAttribute
+		distance int
+	}
+	var candidates []candidate
+	for _, attr := range recordAttributes(r) {
+		if !hm.appliesTo(attr.level) {
+			continue
+		}
+		distance := levenshtein(name, attr.key)
+		if distance > max(len(name), len(attr.key))/2 {
+			continue
+		}
+		candidates = append(candidates, candidate{levelAttribute: attr, distance: distance})
+	}
+	if len(candidates) == 0 {
+		return desc + "\nno similar attribute keys present"
+	}
+	slices.SortStableFunc(candidates, func(a, b candidate) int {
+		return cmp.Compare(a.distance, b.distance)
+	})
+	desc += "\nclosest candidates:"
+	for _, c := range candidates[:min(len(candidates), maxCandidates)] {
+		desc += fmt.Sprintf("\n%s%s=%s (%s)", format.Indent, c.key, formatAttributeValue(c.value), c.level)
+	}
+	return desc
+}
+
+// describePresentAttributes describes the attributes present on the passed log
+// record that violate the passed negated attribute matcher.
+func describePresentAttributes(r *sdklog.Record, hm *HaveAttributeMatcher) string {
+	desc := "\nbut found:"
+	for _, attr := range recordAttributes(r) {
+		if !hm.appliesTo(attr.level) {
+			continue
+		}
+		if success, err := hm.matchAttribute(attr.key, attr.value); err != nil || !success {
+			continue
+		}
+		desc += fmt.Sprintf("\n%s%s=%s (%s)", format.Indent, attr.key, formatAttributeValue(attr.value), attr.level)
+	}
+	return desc
+}
+
+// formatAttributeValue returns the textual representation of the passed
+// any-fied attribute value, quoting string values.
+func formatAttributeValue(value any) string {
+	if s, ok := value.(string); ok {
+		return strconv.Quote(s)
+	}
+	return fmt.Sprintf("%v", value)
+}
+
+// levenshtein returns the Levenshtein edit distance between the strings a and
+// b, operating on runes.
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	curr := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		curr[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			curr[j] = min(prev[j]+1, curr[j-1]+1, prev[j-1]+cost)
+		}
+		prev, curr = curr, prev
+	}
+	return prev[len(rb)]
+}
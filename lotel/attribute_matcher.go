@@ -17,6 +17,7 @@ package lotel
 import (
 	"errors"
 	"fmt"
+	"strconv"
 	"strings"
 
 	"go.opentelemetry.io/otel/log"
@@ -43,6 +44,20 @@ import (
 //   - a GomegaMatcher that matches the name only.
 //   - any other type of value is an error.
 //
+// The string form additionally supports the following mini-language:
+//   - “name” containing “*” and/or “?” is a glob pattern, where “*” matches any
+//     sequence of characters (including dots) and “?” any single character,
+//     such as “http.*”.
+//   - “~regex” matches the attribute key/name against a regular expression,
+//     such as “~^db\..+”.
+//   - “name~=regex” matches the attribute value against a regular expression,
+//     such as “service.version~=^v[0-9]+”. Non-string values never match.
+//   - a leading “!” negates the specification: it must not match any
+//     attribute, such as “!exception.*”.
+//
+// The key/name part ends at the first “=”. The string form is parsed only once
+// when calling HaveAttribute, with any parse errors reported from Match.
+//
 // HaveAttribute accepts actual values of types [log.KeyValue] and also
 // [sdklog.Record]. When actual is a log record as opposed to a key-value pair,
 // HaveAttribute matches against the attribute set of this log record. However,
@@ -54,23 +69,28 @@ import (
 //
 //	HaveAttribute("foo")
 //	HaveAttribute("foo=bar")
+//	HaveAttribute("http.*")
+//	HaveAttribute("service.version~=^v[0-9]+")
+//	HaveAttribute("!exception.message")
 //	HaveAttribute(HaveSuffix("foo"))
 //
-// See also [HaveAttributeWithValue].
+// See also [HaveAttributeWithValue]. To match attributes only at a specific
+// level, use [HaveRecordAttribute], [HaveScopeAttribute], or
+// [HaveResourceAttribute] instead.
 func HaveAttribute(attr any) ty.GomegaMatcher {
 	if s, ok := attr.(string); ok {
-		// single plain string argument, so let's see if it is in "NAME=VALUE"
-		// format...
-		if nam, value, found := strings.Cut(s, "="); found {
-			return &HaveAttributeMatcher{
-				name:         nam,
-				value:        value,
-				nameMatcher:  matcherOrEqual(nam),
-				valueMatcher: matcherOrEqual(value),
-			}
+		// single plain string argument, so let's parse it in the attribute
+		// mini-language once here, deferring any parse error to Match.
+		spec, err := parseAttributeSpec(s)
+		return &HaveAttributeMatcher{
+			name:         spec.key,
+			value:        spec.value,
+			nameMatcher:  spec.nameMatcher,
+			valueMatcher: spec.valueMatcher,
+			keyPattern:   spec.keyPattern,
+			negate:       spec.negate,
+			err:          err,
 		}
-		// it's just "NAME", so we want to match only the name, not the value;
-		// fall through now.
 	}
 	return &HaveAttributeMatcher{
 		name:         attr,
@@ -94,8 +114,7 @@ func HaveAttribute(attr any) ty.GomegaMatcher {
 // of BeARecord, especially when asserting the presence of multiple attributes,
 // as BeARecord optimizes the attributes checks.
 //
-// The value passed into the value parameter can be one of the following, all
-// other values are an error:
+// The value passed into the value parameter can be one of the following:
 //   - nil: represents an OTel “empty” value.
 //   - bool
 //   - int, int64
@@ -105,6 +124,8 @@ func HaveAttribute(attr any) ty.GomegaMatcher {
 //   - []any: represents OTel slice values
 //   - map[string]any: represents OTel map values
 //   - [ty.GomegaMatcher]
+//   - any other type supported by [logconv.Value], such as other integer
+//     widths, time.Time, or structs, converted accordingly.
 //
 // Usage examples:
 //
@@ -113,13 +134,20 @@ func HaveAttribute(attr any) ty.GomegaMatcher {
 //	HaveAttributeWithValue("foo", Not(BeEmpty()))
 //	HaveAttributeWithValue("foo", 42)
 //
-// See also [HaveAttribute].
+// See also [HaveAttribute]. To match numeric values regardless of whether they
+// have been logged as integer or floating point values, use
+// [EqualsValueLoosely] as the expected value.
 func HaveAttributeWithValue(name, value any) ty.GomegaMatcher {
+	valueMatcher, err := matcherOrEqualNilInclusive(value, logconv.TryCanonize)
+	if err != nil {
+		err = fmt.Errorf("cannot convert expected value: %w", err)
+	}
 	return &HaveAttributeMatcher{
 		name:         name,
 		value:        value,
 		nameMatcher:  matcherOrEqual(name),
-		valueMatcher: matcherOrEqualNilInclusive(value, logconv.Canonize),
+		valueMatcher: valueMatcher,
+		err:          err,
 	}
 
 }
@@ -135,6 +163,10 @@ type HaveAttributeMatcher struct {
 	value        any
 	nameMatcher  ty.GomegaMatcher // actual will be of type string
 	valueMatcher ty.GomegaMatcher // actual will be of type any (via logconv.Any)
+	keyPattern   bool             // name is a glob or regular expression
+	negate       bool             // attribute must be absent
+	err          error            // deferred spec parse or value conversion error, if any
+	levels       attributeLevel   // restricts matching to levels, if non-zero
 }
 
 var (
@@ -148,6 +180,9 @@ var (
 // matches against the attributes at the different hierarchical levels of a
 // single log record.
 func (m *HaveAttributeMatcher) matchAttribute(name string, value any) (bool, error) {
+	if err := m.deferredError(); err != nil {
+		return false, err
+	}
 	if m.nameMatcher == nil {
 		return false, fmt.Errorf("HaveAttributeMatcher: name matcher must not be <nil>")
 	}
@@ -165,13 +200,41 @@ func (m *HaveAttributeMatcher) matchAttribute(name string, value any) (bool, err
 	return m.valueMatcher.Match(value)
 }
 
+// appliesTo returns true if this attribute matcher applies to attributes at the
+// specified level.
+func (m *HaveAttributeMatcher) appliesTo(level attributeLevel) bool {
+	return m.levels == allLevels || m.levels&level != 0
+}
+
+// deferredError returns the deferred spec parse or value conversion error, if
+// any.
+func (m *HaveAttributeMatcher) deferredError() error {
+	if m.err == nil {
+		return nil
+	}
+	return fmt.Errorf("HaveAttributeMatcher: %w", m.err)
+}
+
+// negated returns true if this attribute matcher requires the absence of any
+// matching attribute.
+func (m *HaveAttributeMatcher) negated() bool {
+	return m.negate
+}
+
 func (m *HaveAttributeMatcher) Match(actual any) (success bool, err error) {
 	if actual == nil {
 		return false, errors.New("refusing to match <nil>")
 	}
+	if err := m.deferredError(); err != nil {
+		return false, err
+	}
 	switch actual := actual.(type) {
 	case log.KeyValue:
-		return m.matchAttribute(actual.Key, logconv.Any(actual.Value))
+		success, err := m.matchAttribute(actual.Key, logconv.Any(actual.Value))
+		if err != nil {
+			return false, err
+		}
+		return success != m.negate, nil
 	case sdklog.Record:
 		return containsAttributes(&actual, []attributeMatcher{m})
 	}
@@ -180,13 +243,54 @@ func (m *HaveAttributeMatcher) Match(actual any) (success bool, err error) {
 }
 
 func (m *HaveAttributeMatcher) expected() string {
-	expected := "key:\n" + format.Object(m.name, 1)
+	label := "key:\n"
+	if m.negate {
+		label = "absent key:\n"
+	}
+	expected := label + format.Object(m.name, 1)
 	if m.value != nil {
 		expected += "\nvalue:\n" + format.Object(m.value, 1)
 	}
+	if m.levels != allLevels {
+		expected += "\nlevel:\n" + format.IndentString(m.levels.String(), 1)
+	}
 	return expected
 }
 
+// GomegaString returns a compact single-line description of this attribute
+// matcher, instead of Gomega dumping the internals of its name and value
+// matchers.
+func (m *HaveAttributeMatcher) GomegaString() string {
+	var sb strings.Builder
+	sb.WriteString("HaveAttribute{")
+	if m.negate {
+		sb.WriteString("absent ")
+	}
+	sb.WriteString("key: " + compactObject(m.name))
+	if m.value != nil {
+		sb.WriteString(", value: " + compactObject(m.value))
+	}
+	if m.levels != allLevels {
+		sb.WriteString(", level: " + m.levels.String())
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+// compactObject renders the passed expected attribute name or value on a
+// single line, quoting strings.
+func compactObject(v any) string {
+	switch v := v.(type) {
+	case string:
+		return strconv.Quote(v)
+	case format.GomegaStringer:
+		return v.GomegaString()
+	case ty.GomegaMatcher:
+		return fmt.Sprintf("<%T>", v)
+	}
+	return fmt.Sprintf("%#v", v)
+}
+
 func (m *HaveAttributeMatcher) FailureMessage(actual any) (message string) {
 	return fmt.Sprintf("Expected\n%s\nto equal\n%s",
 		format.Object(actual, 1), format.IndentString(m.expected(), 1))
@@ -44,6 +44,99 @@ var _ = Describe("HaveAttribute(WithValue) matchers", func() {
 		Entry(nil, "foo=", "bar", false),
 	)
 
+	DescribeTable("matches attributes using the attribute mini-language",
+		func(attrspec string, key string, attrv any, match bool) {
+			attr := log.KeyValue{Key: key, Value: logconv.Value(attrv)}
+			If(match, Assertion.To, Assertion.NotTo)(Expect(attr),
+				HaveAttribute(attrspec))
+		},
+		Entry(nil, "http.*", "http.request.method", "GET", true),
+		Entry(nil, "http.*", "https.request", "GET", false),
+		Entry(nil, "http.*=GET", "http.request.method", "GET", true),
+		Entry(nil, "http.*=GET", "http.request.method", "PUT", false),
+		Entry(nil, "fo?", "foo", "bar", true),
+		Entry(nil, "fo?", "fooo", "bar", false),
+		Entry(nil, "~^db\\..+", "db.system", "sqlite", true),
+		Entry(nil, "~^db\\..+", "dbsystem", "sqlite", false),
+		Entry(nil, "~^db\\..+=sqlite", "db.system", "sqlite", true),
+		Entry(nil, "version~=^v[0-9]+", "version", "v1.2.3", true),
+		Entry(nil, "version~=^v[0-9]+", "version", "1.2.3", false),
+		Entry(nil, "version~=^v[0-9]+", "version", 42, false),
+		Entry(nil, "~^ver~=^v[0-9]+", "version", "v1", true),
+		Entry(nil, "!foo", "foo", "bar", false),
+		Entry(nil, "!foo", "bar", "bar", true),
+		Entry(nil, "!foo=bar", "foo", "baz", true),
+		Entry(nil, "!http.*", "http.request.method", "GET", false),
+	)
+
+	DescribeTable("matches negated attributes in record, resource, scope",
+		func(m ty.GomegaMatcher, match bool) {
+			r := logtest.RecordFactory{
+				Resource: resource.NewSchemaless(attribute.String("service.name", "foobar")),
+				InstrumentationScope: &instrumentation.Scope{
+					Attributes: attribute.NewSet(attribute.Int("scope.id", 42)),
+				},
+				Attributes: []log.KeyValue{log.String("foo", "bar")},
+			}.NewRecord()
+			If(match, Assertion.To, Assertion.NotTo)(Expect(r), m)
+			If(match, Assertion.To, Assertion.NotTo)(Expect(r), BeARecord(m))
+		},
+		Entry(nil, HaveAttribute("!exception.*"), true),
+		Entry(nil, HaveAttribute("!foo"), false),
+		Entry(nil, HaveAttribute("!service.*"), false),
+		Entry(nil, HaveAttribute("!scope.id"), false),
+		Entry(nil, HaveRecordAttribute("!service.name"), true),
+		Entry(nil, HaveResourceAttribute("!service.name"), false),
+		Entry(nil, HaveAttribute("!foo=baz"), true),
+	)
+
+	It("combines negated and positive attribute expectations", func() {
+		r := logtest.RecordFactory{
+			Attributes: []log.KeyValue{log.String("foo", "bar")},
+		}.NewRecord()
+		Expect(r).To(BeARecord(HaveAttribute("foo"), HaveAttribute("!bar")))
+		Expect(r).NotTo(BeARecord(HaveAttribute("foo"), HaveAttribute("!f*")))
+		Expect(logtest.RecordFactory{}.NewRecord()).To(BeARecord(HaveAttribute("!foo")))
+	})
+
+	It("matches overlapping glob and exact attribute expectations", func() {
+		r := logtest.RecordFactory{
+			Resource: resource.NewSchemaless(attribute.String("service.name", "foobar")),
+			Attributes: []log.KeyValue{
+				log.String("http.method", "GET"),
+				log.Int("http.status", 200),
+			},
+		}.NewRecord()
+		Expect(r).To(BeARecord(HaveAttribute("http.*"), HaveAttribute("http.method")))
+		Expect(r).To(BeARecord(HaveAttribute("http.method"), HaveAttribute("http.*")))
+		Expect(r).To(BeARecord(HaveAttribute("http.method"), HaveAttribute("http.method=GET")))
+		Expect(r).To(BeARecord(HaveAttribute("service.*"), HaveAttribute("service.name")))
+		Expect(r).NotTo(BeARecord(HaveAttribute("http.*"), HaveAttribute("http.url")))
+	})
+
+	It("reports the attributes violating a negated expectation", func() {
+		r := logtest.RecordFactory{
+			Attributes: []log.KeyValue{log.String("http.method", "GET"), log.Int("http.status", 200)},
+		}.NewRecord()
+		m := BeARecord(HaveAttribute("!http.*"))
+		Expect(m.Match(r)).To(BeFalse())
+		Expect(m.FailureMessage(r)).To(MatchRegexp(
+			`(?s)absent key:\s+<string>: http\.\*\s+but found:\s+http\.method="GET" \(record\)\s+http\.status=200 \(record\)`))
+	})
+
+	DescribeTable("reports parse errors from Match",
+		func(attrspec string, errmsg string) {
+			var m ty.GomegaMatcher
+			Expect(func() { m = HaveAttribute(attrspec) }).NotTo(Panic())
+			Expect(m.Match(log.String("foo", "bar"))).Error().To(
+				MatchError(ContainSubstring(errmsg)))
+			Expect(BeARecord(m).Match(logtest.RecordFactory{}.NewRecord())).Error().To(
+				MatchError(ContainSubstring(errmsg)))
+		},
+		Entry(nil, "~(foo", "invalid attribute key regular expression"),
+		Entry(nil, "foo~=(bar", "invalid attribute value regular expression"),
+	)
+
 	DescribeTable("matches attributes using explicit name, value",
 		func(name, value any, attrv any, match bool) {
 			attr := log.KeyValue{Key: "foo", Value: logconv.Value(attrv)}
@@ -84,8 +177,21 @@ var _ = Describe("HaveAttribute(WithValue) matchers", func() {
 			Expect(m.Match(r)).Error().To(HaveOccurred())
 		},
 		Entry(nil, HaveAttribute(BeTrue())),
+		Entry(nil, HaveAttributeWithValue("foo", make(chan struct{}))),
+		Entry(nil, BeARecord(HaveAttributeWithValue("foo", make(chan struct{})))),
+		Entry(nil, HaveBody(make(chan struct{}))),
+		Entry(nil, HaveBodyField("foo", make(chan struct{}))),
 	)
 
+	It("defers expected value conversion errors", func() {
+		var m ty.GomegaMatcher
+		Expect(func() {
+			m = HaveAttributeWithValue("foo", func() {})
+		}).NotTo(Panic())
+		Expect(m.Match(log.String("foo", "bar"))).Error().To(
+			MatchError(ContainSubstring("cannot convert expected value: unsupported type func()")))
+	})
+
 	It("returns matching errors when trying to match resource and scope attributes", func() {
 		r := logtest.RecordFactory{
 			Resource: resource.NewWithAttributes("example.org/foobar",
@@ -0,0 +1,121 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package lotel
+
+import (
+	"fmt"
+	"regexp"
+	"strings"
+
+	g "github.com/onsi/gomega"
+	gc "github.com/onsi/gomega/gcustom"
+	ty "github.com/onsi/gomega/types"
+)
+
+// attributeSpec is a parsed [HaveAttribute] string specification in the
+// attribute mini-language:
+//
+//	[!]KEY[=VALUE|~=VALUE-REGEX]
+//
+// where KEY is either a plain attribute key, a glob pattern containing “*”
+// and/or “?”, or a regular expression when prefixed with “~”.
+type attributeSpec struct {
+	key          string           // key as specified, without any negation
+	value        any              // value as specified, if any; nil otherwise
+	keyPattern   bool             // key is a glob or regular expression
+	negate       bool             // attribute must be absent
+	nameMatcher  ty.GomegaMatcher // actual will be of type string
+	valueMatcher ty.GomegaMatcher // actual will be of type any (via logconv.Any)
+}
+
+// parseAttributeSpec parses the passed attribute string specification,
+// returning an error if the specified key or value regular expression is
+// invalid.
+func parseAttributeSpec(s string) (attributeSpec, error) {
+	var spec attributeSpec
+	if rest, ok := strings.CutPrefix(s, "!"); ok {
+		spec.negate = true
+		s = rest
+	}
+	key, value, found := strings.Cut(s, "=")
+	valueRegexp := false
+	if found {
+		if k, ok := strings.CutSuffix(key, "~"); ok && k != "" {
+			key = k
+			valueRegexp = true
+		}
+	}
+	spec.key = key
+	switch {
+	case strings.HasPrefix(key, "~"):
+		re, err := regexp.Compile(key[1:])
+		if err != nil {
+			return spec, fmt.Errorf("invalid attribute key regular expression %q: %w", key[1:], err)
+		}
+		spec.keyPattern = true
+		spec.nameMatcher = matchRegexp(re)
+	case strings.ContainsAny(key, "*?"):
+		spec.keyPattern = true
+		spec.nameMatcher = matchRegexp(globRegexp(key))
+	default:
+		spec.nameMatcher = g.Equal(key)
+	}
+	if !found {
+		return spec, nil
+	}
+	if !valueRegexp {
+		spec.value = value
+		spec.valueMatcher = g.Equal(value)
+		return spec, nil
+	}
+	re, err := regexp.Compile(value)
+	if err != nil {
+		return spec, fmt.Errorf("invalid attribute value regular expression %q: %w", value, err)
+	}
+	spec.value = "~" + value
+	spec.valueMatcher = matchRegexp(re)
+	return spec, nil
+}
+
+// globRegexp returns the anchored regular expression for the passed glob
+// pattern, where “*” matches any sequence of characters (including dots) and
+// “?” matches any single character.
+func globRegexp(glob string) *regexp.Regexp {
+	var sb strings.Builder
+	sb.WriteString("^")
+	for _, r := range glob {
+		switch r {
+		case '*':
+			sb.WriteString(".*")
+		case '?':
+			sb.WriteString(".")
+		default:
+			sb.WriteString(regexp.QuoteMeta(string(r)))
+		}
+	}
+	sb.WriteString("$")
+	return regexp.MustCompile(sb.String())
+}
+
+// matchRegexp returns a matcher that succeeds if actual is a string matching
+// the passed (pre-compiled) regular expression. Non-string actual values never
+// match.
+func matchRegexp(re *regexp.Regexp) ty.GomegaMatcher {
+	return gc.MakeMatcher(func(actual any) (bool, error) {
+		s, ok := actual.(string)
+		return ok && re.MatchString(s), nil
+	}).WithTemplate("Expected:\n{{.FormattedActual}}\n{{.To}} match regular expression\n{{format .Data 1}}").
+		WithTemplateData(re.String())
+}
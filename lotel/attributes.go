@@ -16,87 +16,198 @@ package lotel
 
 import (
 	"slices"
+	"strings"
 
 	"go.opentelemetry.io/otel/attribute"
 	sdklog "go.opentelemetry.io/otel/sdk/log"
 
-	intslices "github.com/thediveo/otelcheck/x/slices"
 	"github.com/thediveo/otelcheck/lotel/logconv"
 
 	ty "github.com/onsi/gomega/types"
 )
 
+// attributeLevel specifies the level(s) in the OTel log data model hierarchy an
+// attribute matcher applies to. The zero value applies to all levels.
+type attributeLevel uint8
+
+const (
+	resourceLevel attributeLevel = 1 << iota
+	scopeLevel
+	recordLevel
+
+	allLevels attributeLevel = 0
+)
+
+// String returns the textual representation of the attribute level(s).
+func (l attributeLevel) String() string {
+	if l == allLevels {
+		return "any"
+	}
+	var levels []string
+	for _, level := range []struct {
+		level attributeLevel
+		name  string
+	}{
+		{resourceLevel, "resource"},
+		{scopeLevel, "scope"},
+		{recordLevel, "record"},
+	} {
+		if l&level.level != 0 {
+			levels = append(levels, level.name)
+		}
+	}
+	return strings.Join(levels, ", ")
+}
+
 // attributeMatcher marks a Gomega matcher to match OTel log record attributes.
 type attributeMatcher interface {
 	// try to match an attribute by its name and any-fied/canonized value, where
 	// the attribute name/value might come from a log.KeyValue or resource/scope
 	// attribute.KeyValue.
 	matchAttribute(name string, value any) (bool, error)
+	// appliesTo returns true if the matcher applies to attributes at the
+	// specified level.
+	appliesTo(level attributeLevel) bool
+	// negated returns true if the matcher requires that no attribute at the
+	// levels it applies to matches, instead of at least one attribute.
+	negated() bool
+	// deferredError returns any error deferred from creating the matcher, so
+	// it can be reported even when there are no attributes to match at all.
+	deferredError() error
 }
 
 // containsAttributes succeeds if all passed attribute matchers match on (some
 // of) the passed log record's attributes including resource and scope
-// attributes.
+// attributes, taking into account the levels the attribute matchers apply to.
 func containsAttributes(r *sdklog.Record, attrms []attributeMatcher) (bool, error) {
-	attrms, err := removeMatchingMatchers(r.Resource().Set(), slices.Clone(attrms))
+	attrms, err := unmatchedAttributeMatchers(r, attrms)
 	if err != nil {
 		return false, err
 	}
+	return len(attrms) == 0, nil
+}
+
+// unmatchedAttributeMatchers returns those passed attribute matchers that do
+// not match on any of the passed log record's attributes including resource
+// and scope attributes, taking into account the levels the attribute matchers
+// apply to. Negated attribute matchers are returned instead when they match on
+// any of the attributes.
+func unmatchedAttributeMatchers(r *sdklog.Record, attrms []attributeMatcher) ([]attributeMatcher, error) {
+	for _, m := range attrms {
+		if err := m.deferredError(); err != nil {
+			return nil, err
+		}
+	}
+	var negms []attributeMatcher
+	attrms = slices.DeleteFunc(slices.Clone(attrms), func(m attributeMatcher) bool {
+		if m.negated() {
+			negms = append(negms, m)
+			return true
+		}
+		return false
+	})
+	attrms, err := unmatchedPositiveMatchers(r, attrms)
+	if err != nil {
+		return nil, err
+	}
+	for _, m := range negms {
+		present, err := hasMatchingAttribute(r, m)
+		if err != nil {
+			return nil, err
+		}
+		if present {
+			attrms = append(attrms, m)
+		}
+	}
+	return attrms, nil
+}
+
+// unmatchedPositiveMatchers returns those passed (non-negated) attribute
+// matchers that do not match on any of the passed log record's attributes.
+func unmatchedPositiveMatchers(r *sdklog.Record, attrms []attributeMatcher) ([]attributeMatcher, error) {
+	attrms, err := removeMatchingMatchers(r.Resource().Set(), resourceLevel, attrms)
+	if err != nil {
+		return nil, err
+	}
 	if len(attrms) == 0 {
-		return true, nil
+		return attrms, nil
 	}
 	attrs := r.InstrumentationScope().Attributes
-	attrms, err = removeMatchingMatchers(&attrs, attrms)
+	attrms, err = removeMatchingMatchers(&attrs, scopeLevel, attrms)
 	if err != nil {
-		return false, err
+		return nil, err
 	}
 	if len(attrms) == 0 {
-		return true, nil
+		return attrms, nil
 	}
 	// And now, esteemed brethren, we enter the last chance saloon...
-nextRecordAttribute:
 	for attr := range r.WalkAttributes /* sweet iterator */ {
 		if len(attrms) == 0 {
-			return true, nil
+			return attrms, nil
 		}
-		key := attr.Key
-		value := logconv.Any(attr.Value)
-		for midx, m := range attrms {
-			success, err := m.matchAttribute(key, value)
-			if err != nil {
-				return false, err
-			}
-			if success {
-				attrms = intslices.DeleteUnordered(attrms, midx)
-				continue nextRecordAttribute
-			}
+		attrms, err = removeMatchersOf(attr.Key, logconv.Any(attr.Value), recordLevel, attrms)
+		if err != nil {
+			return nil, err
 		}
 	}
-	return len(attrms) == 0, nil
+	return attrms, nil
 }
 
-// removeMatchingMatchers checks which attribute matchers match on the
-// passed attribute set and then returns only the "left-over" non-matching
-// matchers.
-func removeMatchingMatchers(attrs *attribute.Set, attrms []attributeMatcher) ([]attributeMatcher, error) {
+// hasMatchingAttribute returns true if the passed attribute matcher matches any
+// of the passed log record's attributes at the levels it applies to.
+func hasMatchingAttribute(r *sdklog.Record, m attributeMatcher) (bool, error) {
+	for _, attr := range recordAttributes(r) {
+		if !m.appliesTo(attr.level) {
+			continue
+		}
+		success, err := m.matchAttribute(attr.key, attr.value)
+		if err != nil || success {
+			return success, err
+		}
+	}
+	return false, nil
+}
+
+// removeMatchingMatchers checks which attribute matchers applying to the
+// specified level match on the passed attribute set and then returns only the
+// "left-over" non-matching matchers.
+func removeMatchingMatchers(attrs *attribute.Set, level attributeLevel, attrms []attributeMatcher) ([]attributeMatcher, error) {
 	it := attrs.Iter()
-nextAttribute:
 	for it.Next() {
 		if len(attrms) == 0 {
 			return attrms, nil
 		}
 		attr := it.Attribute()
-		value := logconv.Canonize(attr.Value.AsInterface())
-		for midx, m := range attrms {
-			success, err := m.matchAttribute(string(attr.Key), value)
-			if err != nil {
-				return nil, err
-			}
-			if success {
-				attrms = intslices.DeleteUnordered(attrms, midx)
-				continue nextAttribute
-			}
+		var err error
+		attrms, err = removeMatchersOf(string(attr.Key),
+			logconv.Any(logconv.FromAttribute(attr.Value)), level, attrms)
+		if err != nil {
+			return nil, err
+		}
+	}
+	return attrms, nil
+}
+
+// removeMatchersOf removes all attribute matchers applying to the specified
+// level that match the passed attribute name and any-fied value. As each
+// attribute matcher only needs some matching attribute, the same attribute can
+// satisfy multiple attribute matchers, so overlapping specifications such as
+// “http.*” and “http.method” don't compete for the same attribute.
+func removeMatchersOf(name string, value any, level attributeLevel, attrms []attributeMatcher) ([]attributeMatcher, error) {
+	var err error
+	attrms = slices.DeleteFunc(attrms, func(m attributeMatcher) bool {
+		if err != nil || !m.appliesTo(level) {
+			return false
 		}
+		success, merr := m.matchAttribute(name, value)
+		if merr != nil {
+			err = merr
+			return false
+		}
+		return success
+	})
+	if err != nil {
+		return nil, err
 	}
 	return attrms, nil
 }
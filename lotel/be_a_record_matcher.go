@@ -15,25 +15,119 @@
 package lotel
 
 import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
 	sdklog "go.opentelemetry.io/otel/sdk/log"
 
-	gc "github.com/onsi/gomega/gcustom"
+	"github.com/onsi/gomega/format"
 	ty "github.com/onsi/gomega/types"
 )
 
 // BeARecord succeeds if the actual log record satisfies all specified matchers.
 // It is an error for actual not to be of type [sdklog.Record].
+//
+// When BeARecord fails, its failure message shows the failure message of the
+// first failing matcher. In case of attribute matchers, the failure message
+// lists all unmatched attribute expectations together with the closest
+// candidate attributes present on the log record.
 func BeARecord(m ty.GomegaMatcher, ms ...ty.GomegaMatcher) ty.GomegaMatcher {
 	ms = append([]ty.GomegaMatcher{m}, ms...)
-	gms, ams := separateAttributeMatchers(ms)
-	return gc.MakeMatcher(func(r sdklog.Record) (bool, error) {
-		for _, m := range gms {
-			success, err := m.Match(r)
-			if err != nil || !success {
-				return false, err
-			}
+	_, ams := separateAttributeMatchers(ms)
+	return &BeARecordMatcher{
+		matchers:          ms,
+		attributeMatchers: ams,
+		failed:            -1,
+	}
+}
+
+// BeARecordMatcher matches a [sdklog.Record] against a list of matchers,
+// remembering which matcher failed in order to produce helpful failure
+// messages.
+//
+// See also: [BeARecord].
+type BeARecordMatcher struct {
+	matchers          []ty.GomegaMatcher // all matchers in the order specified
+	attributeMatchers []attributeMatcher
+
+	failed    int                // index of the failed non-attribute matcher, or -1
+	unmatched []attributeMatcher // the unmatched attribute matchers, if any
+}
+
+var _ ty.GomegaMatcher = (*BeARecordMatcher)(nil)
+
+func (m *BeARecordMatcher) Match(actual any) (success bool, err error) {
+	m.failed = -1
+	m.unmatched = nil
+	r, ok := actual.(sdklog.Record)
+	if !ok {
+		if actual == nil {
+			return false, errors.New("refusing to match <nil>")
+		}
+		return false, fmt.Errorf("BeARecord expected actual of type <%T>.  Got:\n%s",
+			sdklog.Record{}, format.Object(actual, 1))
+	}
+	for idx, gm := range m.matchers {
+		if _, ok := gm.(attributeMatcher); ok {
+			continue
 		}
-		return containsAttributes(&r, ams)
-	}).WithTemplate("Expected:\n{{.FormattedActual}}\n{{.To}} match\n{{format .Data 1}}").
-		WithTemplateData(ms)
+		success, err := gm.Match(r)
+		if err != nil {
+			return false, err
+		}
+		if !success {
+			m.failed = idx
+			return false, nil
+		}
+	}
+	unmatched, err := unmatchedAttributeMatchers(&r, m.attributeMatchers)
+	if err != nil {
+		return false, err
+	}
+	// report the unmatched attribute matchers in their original order.
+	for _, am := range m.attributeMatchers {
+		if slices.Contains(unmatched, am) {
+			m.unmatched = append(m.unmatched, am)
+		}
+	}
+	return len(m.unmatched) == 0, nil
+}
+
+func (m *BeARecordMatcher) FailureMessage(actual any) (message string) {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Expected:\n%s\nto match\n%s",
+		format.Object(actual, 1), format.IndentString(m.GomegaString(), 1))
+	r, ok := actual.(sdklog.Record)
+	switch {
+	case m.failed >= 0:
+		fmt.Fprintf(&sb, "\nbut matcher #%d failed with:\n%s",
+			m.failed+1,
+			format.IndentString(m.matchers[m.failed].FailureMessage(actual), 1))
+	case len(m.unmatched) != 0 && ok:
+		sb.WriteString("\nbut the following attribute expectations were not met:")
+		for _, am := range m.unmatched {
+			sb.WriteString("\n" + format.IndentString(describeUnmatchedAttribute(&r, am), 1))
+		}
+	}
+	return sb.String()
+}
+
+func (m *BeARecordMatcher) NegatedFailureMessage(actual any) (message string) {
+	return fmt.Sprintf("Expected:\n%s\nnot to match\n%s",
+		format.Object(actual, 1), format.IndentString(m.GomegaString(), 1))
+}
+
+// GomegaString returns a compact description of this matcher, listing its
+// matchers one per line. Matchers not providing their own compact description
+// are listed by their type only, instead of Gomega dumping their internals.
+func (m *BeARecordMatcher) GomegaString() string {
+	var sb strings.Builder
+	sb.WriteString("BeARecord(")
+	for _, gm := range m.matchers {
+		sb.WriteString("\n" + format.Indent + compactObject(gm) + ",")
+	}
+	sb.WriteString("\n)")
+	return sb.String()
 }
@@ -22,6 +22,7 @@ import (
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/thediveo/success"
 )
 
 var _ = Describe("BeARecord matcher", func() {
@@ -60,4 +61,72 @@ var _ = Describe("BeARecord matcher", func() {
 		Expect(r).To(BeARecord(HaveAttribute("foo"), Not(HaveAttribute("baz"))))
 	})
 
+	When("failing", func() {
+
+		r := logtest.RecordFactory{
+			EventName: "org.foo",
+			Attributes: []log.KeyValue{
+				log.String("answer", "42"),
+				log.String("anwser", "41"),
+				log.Int("bar", 666),
+			},
+		}.NewRecord()
+
+		It("pinpoints the failing matcher", func() {
+			m := BeARecord(HaveAttribute("answer"), HaveEventName("org.bar"))
+			Expect(Successful(m.Match(r))).To(BeFalse())
+			Expect(m.FailureMessage(r)).To(MatchRegexp(
+				`(?s)but matcher #2 failed with:\n\s+Expected:.*to match\n\s+<string>: org.bar`))
+			Expect(m.NegatedFailureMessage(r)).To(ContainSubstring("not to match"))
+		})
+
+		It("lists unmatched attribute expectations with closest candidates", func() {
+			m := BeARecord(
+				HaveAttribute("bar"),
+				HaveAttribute("answr=42"),
+				HaveAttribute("foobarbaz"),
+				HaveRecordAttribute(HavePrefix("x")))
+			Expect(Successful(m.Match(r))).To(BeFalse())
+			Expect(m.FailureMessage(r)).To(MatchRegexp(
+				`(?s)but the following attribute expectations were not met:` +
+					`\n\s+key:\n\s+<string>: answr\n\s+value:\n\s+<string>: 42` +
+					`\n\s+closest candidates:\n\s+answer="42" \(record\)\n\s+anwser="41" \(record\)` +
+					`\n\s+key:\n\s+<string>: foobarbaz\n\s+no similar attribute keys present` +
+					`\n\s+key:\n.*HavePrefix.*level:\n\s+record$`))
+		})
+
+		It("describes its matchers compactly", func() {
+			m := BeARecord(
+				HaveAttribute("http.*"),
+				HaveRecordAttribute("answer~=^4"),
+				HaveAttribute("!anwser"),
+				HaveEventName("org.bar"))
+			Expect(Successful(m.Match(r))).To(BeFalse())
+			msg := m.FailureMessage(r)
+			Expect(msg).To(ContainSubstring(`to match
+    BeARecord(
+        HaveAttribute{key: "http.*"},
+        HaveAttribute{key: "answer", value: "~^4", level: record},
+        HaveAttribute{absent key: "anwser"},
+        <gcustom.CustomGomegaMatcher>,
+    )`))
+			Expect(msg).NotTo(ContainSubstring("parse."))
+			Expect(msg).NotTo(ContainSubstring("truncated"))
+			Expect(m.NegatedFailureMessage(r)).To(ContainSubstring(`HaveAttribute{key: "http.*"}`))
+		})
+
+	})
+
+	DescribeTable("Levenshtein distance",
+		func(a, b string, expected int) {
+			Expect(levenshtein(a, b)).To(Equal(expected))
+			Expect(levenshtein(b, a)).To(Equal(expected))
+		},
+		Entry(nil, "", "", 0),
+		Entry(nil, "", "abc", 3),
+		Entry(nil, "kitten", "sitting", 3),
+		Entry(nil, "answer", "anwser", 2),
+		Entry(nil, "äöü", "aöü", 1),
+	)
+
 })
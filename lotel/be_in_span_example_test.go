@@ -0,0 +1,55 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package lotel_test
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/log"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+
+	"github.com/onsi/gomega"
+
+	. "github.com/thediveo/otelcheck/lotel"
+	"github.com/thediveo/otelcheck/lotel/testlogger"
+)
+
+func ExampleBeInSpan() {
+	/* only in testable example */ Ω := gomega.NewGomega(func(message string, _ ...int) { panic(message) })
+
+	// only in testable example, so when no suitable test context is at hand.
+	ctx, cancel := context.WithTimeout(context.TODO(), 30*time.Second)
+	defer cancel()
+
+	tracerProvider := sdktrace.NewTracerProvider()
+	defer func() { _ = tracerProvider.Shutdown(ctx) }()
+
+	logger, shutdown, ch := testlogger.New(10)
+	defer shutdown(ctx)
+
+	// emit a log record while inside a span: the logger automatically sets the
+	// log record's trace and span IDs from the active span in the context.
+	spanCtx, span := tracerProvider.Tracer("example").Start(ctx, "foo")
+	r := log.Record{}
+	r.SetEventName("org.foo")
+	logger.Emit(spanCtx, r)
+	span.End()
+
+	Ω.Eventually(ch).Should(gomega.Receive(BeARecord(
+		HaveEventName("org.foo"),
+		BeInSpan(spanCtx))))
+	// Output:
+}
@@ -0,0 +1,81 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package lotel
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/trace"
+
+	gc "github.com/onsi/gomega/gcustom"
+	ty "github.com/onsi/gomega/types"
+)
+
+// BeInSpan succeeds if the actual log record has been emitted inside the
+// expected span, that is, the log record's trace ID and span ID match the
+// trace ID and span ID of the expected span context. BeInSpan can also be used
+// as a matcher inside [BeARecord].
+//
+// The expected span can be passed as one of the following, all other values
+// are an error:
+//   - [context.Context]: the span context is taken from the active span in the
+//     passed context.
+//   - [trace.SpanContext]
+//   - any value with a SpanContext method returning a [trace.SpanContext],
+//     such as [trace.Span] and
+//     [go.opentelemetry.io/otel/sdk/trace.ReadOnlySpan].
+//
+// It is an error for the expected span context to be invalid, such as when
+// the passed context doesn't carry an active span.
+//
+// Usage example:
+//
+//	ctx, span := tracer.Start(ctx, "foo")
+//	logger.Emit(ctx, r)
+//	Eventually(ch).Should(Receive(BeARecord(HaveEventName("bar"), BeInSpan(ctx))))
+func BeInSpan(span any) ty.GomegaMatcher {
+	sc, scerr := spanContextOf(span)
+	return gc.MakeMatcher(func(r sdklog.Record) (bool, error) {
+		if scerr != nil {
+			return false, scerr
+		}
+		return r.TraceID() == sc.TraceID() && r.SpanID() == sc.SpanID(), nil
+	}).WithTemplate("Expected:\n{{.FormattedActual}}\n{{.To}} be in span\n{{format .Data 1}}").
+		WithTemplateData(sc)
+}
+
+// spanContextOf returns the span context for the passed context, span context,
+// or span.
+func spanContextOf(span any) (trace.SpanContext, error) {
+	var sc trace.SpanContext
+	switch span := span.(type) {
+	case context.Context:
+		sc = trace.SpanContextFromContext(span)
+	case trace.SpanContext:
+		sc = span
+	case interface{ SpanContext() trace.SpanContext }:
+		sc = span.SpanContext()
+	default:
+		return trace.SpanContext{}, fmt.Errorf(
+			"BeInSpan expected a context.Context, trace.SpanContext, or span.  Got:\n%T", span)
+	}
+	if !sc.IsValid() {
+		return trace.SpanContext{}, errors.New("BeInSpan expected a valid span context")
+	}
+	return sc, nil
+}
@@ -0,0 +1,65 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package lotel
+
+// maximumMatching returns a maximum bipartite matching between the specified
+// numbers of left and right nodes, where edge reports whether the left and
+// right nodes with the passed indices can be paired. The returned slice maps
+// each left node index to the index of its paired right node, or -1 if the
+// left node remains unpaired.
+//
+// maximumMatching uses augmenting paths (Kuhn's algorithm), which is perfectly
+// fine for the small numbers of matchers and records or attributes involved.
+func maximumMatching(left, right int, edge func(l, r int) (bool, error)) ([]int, error) {
+	adjacent := make([][]int, left)
+	for l := range left {
+		for r := range right {
+			success, err := edge(l, r)
+			if err != nil {
+				return nil, err
+			}
+			if success {
+				adjacent[l] = append(adjacent[l], r)
+			}
+		}
+	}
+	rightOf := make([]int, left)
+	for l := range rightOf {
+		rightOf[l] = -1
+	}
+	leftOf := make([]int, right)
+	for r := range leftOf {
+		leftOf[r] = -1
+	}
+	var augment func(l int, seen []bool) bool
+	augment = func(l int, seen []bool) bool {
+		for _, r := range adjacent[l] {
+			if seen[r] {
+				continue
+			}
+			seen[r] = true
+			if leftOf[r] < 0 || augment(leftOf[r], seen) {
+				leftOf[r] = l
+				rightOf[l] = r
+				return true
+			}
+		}
+		return false
+	}
+	for l := range left {
+		augment(l, make([]bool, right))
+	}
+	return rightOf, nil
+}
@@ -15,8 +15,6 @@
 package lotel
 
 import (
-	"go.opentelemetry.io/otel/log"
-
 	g "github.com/onsi/gomega"
 	ty "github.com/onsi/gomega/types"
 	"github.com/thediveo/otelcheck/lotel/logconv"
@@ -38,28 +36,37 @@ func matcherOrEqual(expected any) ty.GomegaMatcher {
 // or [g.BeNil] matcher, depending on expected. The dedicated handling of
 // expected nil values allows to match “empty” log values (which we represent as
 // nil after any-fying [log.Value] to any values).
-func matcherOrEqualNilInclusive(expected any, fn ...func(any) any) ty.GomegaMatcher {
+//
+// When passed a conversion function, matcherOrEqualNilInclusive converts a
+// non-nil expected value before wrapping it, returning any conversion error.
+func matcherOrEqualNilInclusive(expected any, fn ...func(any) (any, error)) (ty.GomegaMatcher, error) {
 	if m, ok := expected.(ty.GomegaMatcher); ok {
-		return m
+		return m, nil
 	}
 	if expected == nil {
-		return g.BeNil()
+		return g.BeNil(), nil
 	}
 	if len(fn) > 0 {
-		return g.Equal(fn[0](expected))
+		expected, err := fn[0](expected)
+		if err != nil {
+			return nil, err
+		}
+		return g.Equal(expected), nil
 	}
-	return g.Equal(expected)
+	return g.Equal(expected), nil
 }
 
 // valueMatcher either returns any passed-in [ty.GomegaMatcher] value as is
 // and otherwise wraps all other expected values into a [EqualsValue] matcher,
-// translating the expected(!) value into a log value, where necessary.
-func valueMatcher(expected any) ty.GomegaMatcher {
+// translating the expected(!) value into a log value, where necessary. It
+// returns an error if the expected value cannot be translated.
+func valueMatcher(expected any) (ty.GomegaMatcher, error) {
 	if m, ok := expected.(ty.GomegaMatcher); ok {
-		return m
+		return m, nil
 	}
-	if expected, ok := expected.(log.Value); ok {
-		return EqualsValue(expected)
+	v, err := logconv.TryValue(expected)
+	if err != nil {
+		return nil, err
 	}
-	return EqualsValue(logconv.Value(expected))
+	return EqualsValue(v), nil
 }
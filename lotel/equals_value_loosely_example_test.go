@@ -0,0 +1,38 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package lotel_test
+
+import (
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/sdk/log/logtest"
+
+	"github.com/onsi/gomega"
+
+	. "github.com/thediveo/otelcheck/lotel"
+)
+
+func ExampleEqualsValueLoosely() {
+	/* only in testable example */ Ω := gomega.NewGomega(func(message string, _ ...int) { panic(message) })
+
+	record := logtest.RecordFactory{
+		Attributes: []log.KeyValue{log.Float64("ratio", 1)},
+	}.NewRecord()
+
+	// the ratio attribute has been logged as a float, so a strict comparison
+	// with an int fails, while a loose comparison succeeds.
+	Ω.Expect(record).NotTo(HaveAttributeWithValue("ratio", 1))
+	Ω.Expect(record).To(HaveAttributeWithValue("ratio", EqualsValueLoosely(1)))
+	// Output:
+}
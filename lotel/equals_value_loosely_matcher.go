@@ -0,0 +1,127 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package lotel
+
+import (
+	"fmt"
+	"math"
+	"reflect"
+
+	"github.com/thediveo/otelcheck/lotel/logconv"
+
+	gc "github.com/onsi/gomega/gcustom"
+	ty "github.com/onsi/gomega/types"
+)
+
+// EqualsValueLoosely succeeds if actual is loosely equal to the expected value.
+// Both actual and expected can either be [go.opentelemetry.io/otel/log.Value]
+// values or any Go values supported by [logconv.Canonize]. This allows
+// EqualsValueLoosely to be used both stand-alone as well as for the expected
+// value of [HaveAttributeWithValue], for instance:
+//
+//	HaveAttributeWithValue("ratio", EqualsValueLoosely(1))
+//
+// In contrast to the strict comparison of [EqualsValue] and
+// [HaveAttributeWithValue], EqualsValueLoosely considers:
+//   - integer and floating point values to be equal if they are numerically
+//     equal, such as 1 and 1.0,
+//   - byte slices and strings to be equal if the bytes equal the string,
+//   - slices to be equal if they are of the same length and their elements are
+//     loosely equal,
+//   - maps to be equal if they have the same keys and the values of the same
+//     keys are loosely equal.
+func EqualsValueLoosely(expected any) ty.GomegaMatcher {
+	canonical, err := logconv.TryCanonize(expected)
+	return gc.MakeMatcher(func(actual any) (bool, error) {
+		if err != nil {
+			return false, fmt.Errorf("EqualsValueLoosely: cannot convert expected value: %w", err)
+		}
+		actual, aerr := logconv.TryCanonize(actual)
+		if aerr != nil {
+			return false, fmt.Errorf("EqualsValueLoosely: cannot convert actual value: %w", aerr)
+		}
+		return looselyEqual(actual, canonical), nil
+	}).WithTemplate("Expected:\n{{.FormattedActual}}\n{{.To}} loosely equal\n{{format .Data 1}}").
+		WithTemplateData(expected)
+}
+
+// looselyEqual returns true if the passed canonical values are loosely equal;
+// see [EqualsValueLoosely] for details.
+func looselyEqual(a, b any) bool {
+	switch a := a.(type) {
+	case int64:
+		switch b := b.(type) {
+		case int64:
+			return a == b
+		case float64:
+			return intEqualsFloat(a, b)
+		}
+	case float64:
+		switch b := b.(type) {
+		case int64:
+			return intEqualsFloat(b, a)
+		case float64:
+			return a == b
+		}
+	case string:
+		switch b := b.(type) {
+		case string:
+			return a == b
+		case []byte:
+			return a == string(b)
+		}
+	case []byte:
+		switch b := b.(type) {
+		case string:
+			return string(a) == b
+		case []byte:
+			return string(a) == string(b)
+		}
+	case []any:
+		b, ok := b.([]any)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for idx := range a {
+			if !looselyEqual(a[idx], b[idx]) {
+				return false
+			}
+		}
+		return true
+	case map[string]any:
+		b, ok := b.(map[string]any)
+		if !ok || len(a) != len(b) {
+			return false
+		}
+		for key, aval := range a {
+			bval, ok := b[key]
+			if !ok || !looselyEqual(aval, bval) {
+				return false
+			}
+		}
+		return true
+	}
+	return reflect.DeepEqual(a, b)
+}
+
+// intEqualsFloat returns true if the passed float value is integral and equals
+// the passed int value, avoiding any rounding artefacts of converting large
+// int64 values into float64 values.
+func intEqualsFloat(i int64, f float64) bool {
+	if f != math.Trunc(f) || f < math.MinInt64 || f >= math.MaxInt64 {
+		return false
+	}
+	return int64(f) == i
+}
@@ -0,0 +1,74 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package lotel
+
+import (
+	"math"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/sdk/log/logtest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/thediveo/otelcheck/x/iff"
+)
+
+var _ = Describe("EqualsValueLoosely matcher", func() {
+
+	DescribeTable("loosely matching values",
+		func(actual, expected any, match bool) {
+			If(match, Assertion.To, Assertion.NotTo)(Expect(actual), EqualsValueLoosely(expected))
+		},
+		Entry("int and float", int64(1), 1.0, true),
+		Entry("float and int", 1.0, 1, true),
+		Entry("non-integral float and int", 1.5, 1, false),
+		Entry("large int and float", int64(math.MaxInt64), float64(math.MaxInt64), false),
+		Entry("different numbers", int64(1), 2.0, false),
+		Entry("bytes and string", []byte("foo"), "foo", true),
+		Entry("string and bytes", "foo", []byte("foo"), true),
+		Entry("bytes and different string", []byte("foo"), "bar", false),
+		Entry("bool", true, true, true),
+		Entry("bool and int", true, 1, false),
+		Entry("nil", nil, nil, true),
+		Entry("slices", []any{int64(1), []byte("foo")}, []any{1.0, "foo"}, true),
+		Entry("slices of different length", []any{int64(1)}, []int{1, 2}, false),
+		Entry("slice and non-slice", []any{int64(1)}, 1, false),
+		Entry("maps", map[string]any{"foo": int64(1)}, map[string]float64{"foo": 1}, true),
+		Entry("maps with different keys", map[string]any{"foo": int64(1)}, map[string]int{"bar": 1}, false),
+		Entry("log values", log.Float64Value(42), log.IntValue(42), true),
+	)
+
+	It("loosely matches record attribute values", func() {
+		r := logtest.RecordFactory{
+			Attributes: []log.KeyValue{log.Float64("ratio", 1), log.Bytes("data", []byte("foo"))},
+		}.NewRecord()
+		Expect(r).NotTo(HaveAttributeWithValue("ratio", 1))
+		Expect(r).To(HaveAttributeWithValue("ratio", EqualsValueLoosely(1)))
+		Expect(r).To(HaveAttributeWithValue("data", EqualsValueLoosely("foo")))
+	})
+
+	It("returns errors", func() {
+		Expect(EqualsValueLoosely(make(chan int)).Match(42)).Error().To(
+			MatchError(ContainSubstring("cannot convert expected value")))
+		Expect(EqualsValueLoosely(42).Match(make(chan int))).Error().To(
+			MatchError(ContainSubstring("cannot convert actual value")))
+	})
+
+	It("returns failure messages", func() {
+		Expect(EqualsValueLoosely(42).FailureMessage(1.5)).To(
+			ContainSubstring("to loosely equal"))
+	})
+
+})
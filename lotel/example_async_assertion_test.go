@@ -19,6 +19,7 @@ import (
 	"time"
 
 	"github.com/thediveo/otelcheck/lotel/testlogger"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/log"
 
 	"github.com/onsi/gomega"
@@ -35,7 +36,10 @@ func Example_asynchronous_assertions() {
 	defer cancel()
 
 	// let's start with the actual example test code now...
-	logger, shutdown, ch := testlogger.New(10)
+	logger, shutdown, ch := testlogger.New(10,
+		testlogger.WithResourceAttributes(
+			attribute.String("service.name", "foobar"),
+			attribute.String("service.version", "v1.2.3")))
 	defer shutdown(ctx)
 
 	// let's log a few records asynchronously.
@@ -62,7 +66,8 @@ func Example_asynchronous_assertions() {
 			HaveAttribute("bar=barf!"),
 			// ...but also resource and instrument/scope attributes.
 			HaveAttributeWithValue(testlogger.InstrumentationAttributeName, testlogger.InstrumentationAttributeValue),
-			HaveAttributeWithValue("service.name", gomega.HavePrefix("unknown_service:")),
+			HaveAttribute("service.name=foobar"),
+			HaveAttribute("service.version=v1.2.3"),
 		)))
 
 	Ω.Eventually(ch).ShouldNot(
@@ -0,0 +1,108 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package lotel
+
+import (
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+
+	"github.com/thediveo/otelcheck/lotel/logconv"
+)
+
+// FlatRecord is a flat, plain view of an OpenTelemetry [sdklog.Record],
+// including its instrumentation scope and resource information. All values are
+// plain Go values, with attribute and body values converted using
+// [logconv.Any]. FlatRecord thus can be used with gstruct's MatchFields and
+// friends, as well as marshalled to JSON, such as for golden files.
+//
+// Use [Flatten] to create a FlatRecord from a log record.
+type FlatRecord struct {
+	Timestamp         time.Time    `json:"timestamp"`
+	ObservedTimestamp time.Time    `json:"observedTimestamp"`
+	Severity          log.Severity `json:"severity"`
+	SeverityText      string       `json:"severityText,omitempty"`
+	Body              any          `json:"body,omitempty"`
+	EventName         string       `json:"eventName,omitempty"`
+
+	// Hex-encoded trace and span IDs, empty if not valid.
+	TraceID    string `json:"traceId,omitempty"`
+	SpanID     string `json:"spanId,omitempty"`
+	TraceFlags uint8  `json:"traceFlags,omitempty"`
+
+	ScopeName      string `json:"scopeName,omitempty"`
+	ScopeVersion   string `json:"scopeVersion,omitempty"`
+	ScopeSchemaURL string `json:"scopeSchemaUrl,omitempty"`
+
+	ResourceSchemaURL string `json:"resourceSchemaUrl,omitempty"`
+
+	// Attributes at the resource, instrumentation scope and log record levels.
+	ResourceAttributes map[string]any `json:"resourceAttributes,omitempty"`
+	ScopeAttributes    map[string]any `json:"scopeAttributes,omitempty"`
+	Attributes         map[string]any `json:"attributes,omitempty"`
+}
+
+// Flatten returns a flat view of the passed log record. Attribute maps without
+// any attributes are nil.
+func Flatten(r sdklog.Record) FlatRecord {
+	fr := FlatRecord{
+		Timestamp:         r.Timestamp(),
+		ObservedTimestamp: r.ObservedTimestamp(),
+		Severity:          r.Severity(),
+		SeverityText:      r.SeverityText(),
+		Body:              logconv.Any(r.Body()),
+		EventName:         r.EventName(),
+		TraceFlags:        uint8(r.TraceFlags()),
+	}
+	if traceID := r.TraceID(); traceID.IsValid() {
+		fr.TraceID = traceID.String()
+	}
+	if spanID := r.SpanID(); spanID.IsValid() {
+		fr.SpanID = spanID.String()
+	}
+	scope := r.InstrumentationScope()
+	fr.ScopeName = scope.Name
+	fr.ScopeVersion = scope.Version
+	fr.ScopeSchemaURL = scope.SchemaURL
+	fr.ScopeAttributes = flattenAttributeSet(&scope.Attributes)
+	if res := r.Resource(); res != nil {
+		fr.ResourceSchemaURL = res.SchemaURL()
+		fr.ResourceAttributes = flattenAttributeSet(res.Set())
+	}
+	if r.AttributesLen() > 0 {
+		fr.Attributes = make(map[string]any, r.AttributesLen())
+		for attr := range r.WalkAttributes {
+			fr.Attributes[attr.Key] = logconv.Any(attr.Value)
+		}
+	}
+	return fr
+}
+
+// flattenAttributeSet returns the passed attribute set as a map of attribute
+// names to their canonized values, or nil if the set is empty.
+func flattenAttributeSet(attrs *attribute.Set) map[string]any {
+	if attrs.Len() == 0 {
+		return nil
+	}
+	m := make(map[string]any, attrs.Len())
+	it := attrs.Iter()
+	for it.Next() {
+		attr := it.Attribute()
+		m[string(attr.Key)] = logconv.Any(logconv.FromAttribute(attr.Value))
+	}
+	return m
+}
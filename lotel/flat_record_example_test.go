@@ -0,0 +1,51 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package lotel_test
+
+import (
+	"encoding/json"
+	"fmt"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/sdk/log/logtest"
+
+	"github.com/thediveo/otelcheck/lotel"
+)
+
+func ExampleFlatten() {
+	r := logtest.RecordFactory{
+		Severity:  log.SeverityInfo,
+		Body:      log.StringValue("DOH!"),
+		EventName: "org.foo",
+		Attributes: []log.KeyValue{
+			log.Int("answer", 42),
+		},
+	}.NewRecord()
+
+	fr := lotel.Flatten(r)
+	b, _ := json.MarshalIndent(fr, "", "  ")
+	fmt.Println(string(b))
+	// Output:
+	// {
+	//   "timestamp": "0001-01-01T00:00:00Z",
+	//   "observedTimestamp": "0001-01-01T00:00:00Z",
+	//   "severity": 9,
+	//   "body": "DOH!",
+	//   "eventName": "org.foo",
+	//   "attributes": {
+	//     "answer": 42
+	//   }
+	// }
+}
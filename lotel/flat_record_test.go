@@ -0,0 +1,100 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package lotel
+
+import (
+	"encoding/json"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/log/logtest"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/trace"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/onsi/gomega/gstruct"
+	. "github.com/thediveo/success"
+)
+
+var _ = Describe("flat records", func() {
+
+	It("flattens an empty record", func() {
+		fr := Flatten(logtest.RecordFactory{}.NewRecord())
+		Expect(fr.Body).To(BeNil())
+		Expect(fr.TraceID).To(BeEmpty())
+		Expect(fr.SpanID).To(BeEmpty())
+		Expect(fr.Attributes).To(BeNil())
+		Expect(fr.ScopeAttributes).To(BeNil())
+		Expect(fr.ResourceAttributes).To(BeNil())
+	})
+
+	It("flattens a record", func() {
+		now := time.Now()
+		r := logtest.RecordFactory{
+			Timestamp:         now,
+			ObservedTimestamp: now.Add(time.Second),
+			Severity:          log.SeverityWarn,
+			SeverityText:      "WARN",
+			Body:              log.StringValue("DOH!"),
+			EventName:         "org.foo",
+			TraceID:           trace.TraceID{1, 2, 3},
+			SpanID:            trace.SpanID{4, 5, 6},
+			TraceFlags:        trace.FlagsSampled,
+			Resource: resource.NewWithAttributes("https://example.org/schema",
+				attribute.String("service.name", "foobar")),
+			InstrumentationScope: &instrumentation.Scope{
+				Name:       "ourpkg",
+				Version:    "v1.2.3",
+				SchemaURL:  "https://example.org/scope",
+				Attributes: attribute.NewSet(attribute.Int("scope.id", 42)),
+			},
+			Attributes: []log.KeyValue{
+				log.Int("answer", 42),
+				log.Slice("list", log.StringValue("foo"), log.BoolValue(true)),
+			},
+		}.NewRecord()
+
+		Expect(Flatten(r)).To(MatchAllFields(Fields{
+			"Timestamp":          BeTemporally("==", now),
+			"ObservedTimestamp":  BeTemporally("==", now.Add(time.Second)),
+			"Severity":           Equal(log.SeverityWarn),
+			"SeverityText":       Equal("WARN"),
+			"Body":               Equal("DOH!"),
+			"EventName":          Equal("org.foo"),
+			"TraceID":            Equal("01020300000000000000000000000000"),
+			"SpanID":             Equal("0405060000000000"),
+			"TraceFlags":         Equal(uint8(1)),
+			"ScopeName":          Equal("ourpkg"),
+			"ScopeVersion":       Equal("v1.2.3"),
+			"ScopeSchemaURL":     Equal("https://example.org/scope"),
+			"ResourceSchemaURL":  Equal("https://example.org/schema"),
+			"ResourceAttributes": Equal(map[string]any{"service.name": "foobar"}),
+			"ScopeAttributes":    Equal(map[string]any{"scope.id": int64(42)}),
+			"Attributes": Equal(map[string]any{
+				"answer": int64(42),
+				"list":   []any{"foo", true},
+			}),
+		}))
+
+		Expect(string(Successful(json.Marshal(Flatten(r))))).To(And(
+			ContainSubstring(`"eventName":"org.foo"`),
+			ContainSubstring(`"traceId":"01020300000000000000000000000000"`),
+			ContainSubstring(`"attributes":{"answer":42,"list":["foo",true]}`)))
+	})
+
+})
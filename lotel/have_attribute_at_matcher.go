@@ -0,0 +1,43 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package lotel
+
+import (
+	ty "github.com/onsi/gomega/types"
+)
+
+// HaveAttributeAt succeeds if an OpenTelemetry log record has an attribute with
+// the specified key/name that has a structured value with a value matching the
+// expected value at the specified path, including matching resource and
+// instrumentation/scope-level attributes. The path and expected value work
+// exactly as with [HaveBodyField].
+//
+// As HaveAttributeAt returns an attribute matcher, it should be preferably used
+// in the context of [BeARecord]. Invalid paths as well as expected values that
+// cannot be converted are reported as errors when matching.
+//
+// Usage example:
+//
+//	HaveAttributeAt("http.request", "headers.accept", "text/plain")
+func HaveAttributeAt(key any, path string, expected any) ty.GomegaMatcher {
+	valueMatcher := haveValueAt(path, expected)
+	return &HaveAttributeMatcher{
+		name:         key,
+		value:        struct{ Path, Expected any }{path, expected},
+		nameMatcher:  matcherOrEqual(key),
+		valueMatcher: valueMatcher,
+		err:          valueMatcher.err, // report even without matching key
+	}
+}
@@ -0,0 +1,48 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package lotel
+
+import (
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+
+	"github.com/thediveo/otelcheck/lotel/logconv"
+
+	gc "github.com/onsi/gomega/gcustom"
+	ty "github.com/onsi/gomega/types"
+)
+
+// HaveBodyField succeeds if the actual log record has a structured body with a
+// value matching the expected value at the specified path. The path descends
+// into map and slice values and is either in dotted syntax, such as
+// “user.roles.0”, or in JSON pointer syntax (RFC 6901), such as
+// “/user/roles/0”. Use the JSON pointer syntax for map keys containing dots.
+//
+// The expected value can be a plain value, compared after converting it in the
+// same way as with [HaveAttributeWithValue], or a [ty.GomegaMatcher] that gets
+// passed the value found after conversion using [logconv.Any]. HaveBodyField
+// fails if there is no value at the specified path. An invalid path is an
+// error.
+//
+// Usage examples:
+//
+//	HaveBodyField("user.name", "foo")
+//	HaveBodyField("/user/roles", ContainElement("admin"))
+func HaveBodyField(path string, expected any) ty.GomegaMatcher {
+	m := haveValueAt(path, expected)
+	return gc.MakeMatcher(func(r sdklog.Record) (bool, error) {
+		return m.Match(logconv.Any(r.Body()))
+	}).WithTemplate("Expected:\n{{.FormattedActual}}\n{{.To}} have body field\n{{format .Data.Path 1}}\nmatching\n{{format .Data.Expected 1}}").
+		WithTemplateData(struct{ Path, Expected any }{path, expected})
+}
@@ -25,8 +25,11 @@ import (
 // body value can be a log value-compatible any value, an [sdklog.Value], or a
 // [ty.GomegaMatcher].
 func HaveBody(expected any) ty.GomegaMatcher {
-	m := valueMatcher(expected)
+	m, err := valueMatcher(expected)
 	return gc.MakeMatcher(func(r sdklog.Record) (bool, error) {
+		if err != nil {
+			return false, err
+		}
 		return m.Match(r.Body())
 	}).WithTemplate("Expected:\n{{.FormattedActual}}\n{{.To}} match\n{{format .Data 1}}").
 		WithTemplateData(expected)
@@ -0,0 +1,172 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package lotel
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+
+	"github.com/thediveo/otelcheck/lotel/logconv"
+
+	"github.com/onsi/gomega/format"
+	ty "github.com/onsi/gomega/types"
+)
+
+// HaveExactlyAttributes succeeds if the log record-level attributes of an
+// OpenTelemetry log record exactly match the specified attributes: there must
+// be neither missing nor any unexpected attributes. Resource and
+// instrumentation/scope-level attributes are ignored.
+//
+// Each expected attribute passed in attrs can be one of the following:
+//   - a string in the “name” or “name=value” form, as with [HaveAttribute].
+//     Negated “!name” forms require that no record attribute matches.
+//   - an attribute matcher, such as returned by [HaveAttribute] and
+//     [HaveAttributeWithValue].
+//   - any other [ty.GomegaMatcher] that matches the name only.
+//
+// Each expected attribute matches at most one record attribute and vice versa,
+// where HaveExactlyAttributes finds the best possible pairing even for
+// overlapping expectations, such as “http.*” and “http.method”. The failure
+// message lists the missing and unexpected attributes separately.
+//
+// As HaveExactlyAttributes matches record-level attributes only, passing
+// attribute matchers restricted to other levels, such as
+// [HaveResourceAttribute] and [HaveScopeAttribute], is an error.
+//
+// Usage example:
+//
+//	HaveExactlyAttributes("foo=bar", HaveAttributeWithValue("answer", 42))
+func HaveExactlyAttributes(attrs ...any) ty.GomegaMatcher {
+	m := &HaveExactlyAttributesMatcher{
+		attrs: attrs,
+	}
+	for _, attr := range attrs {
+		if am, ok := attr.(attributeMatcher); ok {
+			m.attrms = append(m.attrms, am)
+			continue
+		}
+		m.attrms = append(m.attrms, HaveAttribute(attr).(attributeMatcher))
+	}
+	return m
+}
+
+// HaveExactlyAttributesMatcher matches the log record-level attributes of a
+// [sdklog.Record] exactly against its list of expected attributes.
+//
+// See also: [HaveExactlyAttributes].
+type HaveExactlyAttributesMatcher struct {
+	attrs      []any
+	attrms     []attributeMatcher
+	missing    []any    // expected attributes without matching record attribute
+	unexpected []string // keys of unexpected record attributes
+	present    []any    // negated attributes with matching record attribute
+}
+
+var _ ty.GomegaMatcher = (*HaveExactlyAttributesMatcher)(nil)
+
+func (m *HaveExactlyAttributesMatcher) Match(actual any) (success bool, err error) {
+	if actual == nil {
+		return false, errors.New("refusing to match <nil>")
+	}
+	r, ok := actual.(sdklog.Record)
+	if !ok {
+		return false, fmt.Errorf("HaveExactlyAttributes expected actual of type <%T>.  Got:\n%s",
+			sdklog.Record{}, format.Object(actual, 1))
+	}
+	m.missing = nil
+	m.unexpected = nil
+	m.present = nil
+	for midx, am := range m.attrms {
+		if !am.appliesTo(recordLevel) {
+			return false, fmt.Errorf("HaveExactlyAttributes matches record-level attributes only, but attribute #%d is restricted to other levels:\n%s",
+				midx, format.Object(m.attrs[midx], 1))
+		}
+		if err := am.deferredError(); err != nil {
+			return false, err
+		}
+	}
+	var attrs []levelAttribute
+	for attr := range r.WalkAttributes {
+		attrs = append(attrs, levelAttribute{
+			level: recordLevel,
+			key:   attr.Key,
+			value: logconv.Any(attr.Value),
+		})
+	}
+	// negated attribute expectations must not match any record attribute,
+	// while all other expectations need to be paired up one-to-one with the
+	// record attributes.
+	var positive []int
+	for midx, am := range m.attrms {
+		if !am.negated() {
+			positive = append(positive, midx)
+			continue
+		}
+		for _, attr := range attrs {
+			success, err := am.matchAttribute(attr.key, attr.value)
+			if err != nil {
+				return false, err
+			}
+			if success {
+				m.present = append(m.present, m.attrs[midx])
+				break
+			}
+		}
+	}
+	pairing, err := maximumMatching(len(positive), len(attrs), func(l, r int) (bool, error) {
+		return m.attrms[positive[l]].matchAttribute(attrs[r].key, attrs[r].value)
+	})
+	if err != nil {
+		return false, err
+	}
+	paired := make([]bool, len(attrs))
+	for l, r := range pairing {
+		if r < 0 {
+			m.missing = append(m.missing, m.attrs[positive[l]])
+			continue
+		}
+		paired[r] = true
+	}
+	for r, attr := range attrs {
+		if !paired[r] {
+			m.unexpected = append(m.unexpected, attr.key)
+		}
+	}
+	return len(m.missing) == 0 && len(m.unexpected) == 0 && len(m.present) == 0, nil
+}
+
+func (m *HaveExactlyAttributesMatcher) FailureMessage(actual any) (message string) {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Expected\n%s\nto have exactly the attributes\n%s",
+		format.Object(actual, 1), format.Object(m.attrs, 1))
+	if len(m.missing) != 0 {
+		fmt.Fprintf(&sb, "\nthe missing attributes were\n%s", format.Object(m.missing, 1))
+	}
+	if len(m.unexpected) != 0 {
+		fmt.Fprintf(&sb, "\nthe unexpected attribute keys were\n%s", format.Object(m.unexpected, 1))
+	}
+	if len(m.present) != 0 {
+		fmt.Fprintf(&sb, "\nthe attributes expected to be absent but present were\n%s", format.Object(m.present, 1))
+	}
+	return sb.String()
+}
+
+func (m *HaveExactlyAttributesMatcher) NegatedFailureMessage(actual any) (message string) {
+	return fmt.Sprintf("Expected\n%s\nnot to have exactly the attributes\n%s",
+		format.Object(actual, 1), format.Object(m.attrs, 1))
+}
@@ -0,0 +1,117 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package lotel
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/sdk/log/logtest"
+	"go.opentelemetry.io/otel/sdk/resource"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	. "github.com/thediveo/otelcheck/x/iff"
+	. "github.com/thediveo/success"
+)
+
+var _ = Describe("HaveExactlyAttributes matcher", func() {
+
+	record := logtest.RecordFactory{
+		Resource: resource.NewSchemaless(attribute.String("service.name", "foobar")),
+		Attributes: []log.KeyValue{
+			log.String("foo", "bar"),
+			log.Int("answer", 42),
+		},
+	}.NewRecord()
+
+	It("rejects invalid actual values", func() {
+		Expect(HaveExactlyAttributes().Match(nil)).Error().To(HaveOccurred())
+		Expect(HaveExactlyAttributes().Match(42)).Error().To(
+			MatchError(ContainSubstring("HaveExactlyAttributes expected actual of type")))
+	})
+
+	DescribeTable("matching exactly the record attributes",
+		func(attrs []any, match bool) {
+			If(match, Assertion.To, Assertion.NotTo)(
+				Expect(record), HaveExactlyAttributes(attrs...))
+			If(match, Assertion.To, Assertion.NotTo)(
+				Expect(record), BeARecord(HaveExactlyAttributes(attrs...)))
+		},
+		Entry(nil, []any{"foo", "answer"}, true),
+		Entry(nil, []any{"answer", "foo=bar"}, true),
+		Entry(nil, []any{HaveAttributeWithValue("answer", 42), HavePrefix("f")}, true),
+		Entry(nil, []any{"foo"}, false),
+		Entry(nil, []any{"foo", "answer", "bar"}, false),
+		Entry(nil, []any{"foo", "foo"}, false),
+		Entry(nil, []any{"foo", "answer", "service.name"}, false),
+		Entry(nil, []any{}, false),
+		Entry(nil, []any{"foo", "answer", "!bar"}, true),
+		Entry(nil, []any{"*", "answer"}, true),
+		Entry(nil, []any{"*", "*", "answer"}, false),
+		Entry(nil, []any{"f*", "answer", "!an*"}, false),
+	)
+
+	It("lists missing and unexpected attributes separately", func() {
+		m := HaveExactlyAttributes("foo", "bar")
+		Expect(Successful(m.Match(record))).To(BeFalse())
+		Expect(m.FailureMessage(record)).To(And(
+			MatchRegexp(`the missing attributes were\n\s+<\[\]interface \{\} \| len:1, cap:1>: \[<string>"bar"\]`),
+			MatchRegexp(`the unexpected attribute keys were\n\s+<\[\]string \| len:1, cap:1>: \["answer"\]`)))
+		Expect(m.NegatedFailureMessage(record)).To(
+			ContainSubstring("not to have exactly the attributes"))
+	})
+
+	It("omits missing or unexpected sections when empty", func() {
+		m := HaveExactlyAttributes("foo", "answer", "bar")
+		Expect(Successful(m.Match(record))).To(BeFalse())
+		msg := m.FailureMessage(record)
+		Expect(msg).To(ContainSubstring("the missing attributes were"))
+		Expect(msg).NotTo(ContainSubstring("the unexpected attribute keys were"))
+	})
+
+	It("lists negated attributes that are present", func() {
+		m := HaveExactlyAttributes("foo", "answer", "!ans*")
+		Expect(Successful(m.Match(record))).To(BeFalse())
+		msg := m.FailureMessage(record)
+		Expect(msg).To(MatchRegexp(`the attributes expected to be absent but present were\n\s+.*"!ans\*"`))
+		Expect(msg).NotTo(ContainSubstring("the missing attributes were"))
+	})
+
+	It("pairs up overlapping expectations", func() {
+		r := logtest.RecordFactory{
+			Attributes: []log.KeyValue{
+				log.String("http.method", "GET"),
+				log.Int("http.status", 200),
+			},
+		}.NewRecord()
+		Expect(r).To(HaveExactlyAttributes("http.*", "http.method"))
+		Expect(r).To(HaveExactlyAttributes("http.method", "http.*"))
+
+		m := HaveExactlyAttributes("http.*", "http.method", "http.*")
+		Expect(Successful(m.Match(r))).To(BeFalse())
+		msg := m.FailureMessage(r)
+		Expect(msg).To(MatchRegexp(`the missing attributes were\n\s+.*: \[<string>"http\.\*"\]`))
+		Expect(msg).NotTo(ContainSubstring("the unexpected attribute keys were"))
+	})
+
+	It("rejects attribute matchers restricted to other levels", func() {
+		Expect(HaveExactlyAttributes("foo", HaveResourceAttribute("service.name")).Match(record)).Error().To(
+			MatchError(ContainSubstring("matches record-level attributes only")))
+		Expect(HaveExactlyAttributes(HaveScopeAttribute("foo"), "answer").Match(record)).Error().To(
+			MatchError(ContainSubstring("attribute #0 is restricted to other levels")))
+		Expect(HaveExactlyAttributes(HaveRecordAttribute("foo"), "answer").Match(record)).To(BeTrue())
+	})
+
+})
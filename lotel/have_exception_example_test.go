@@ -0,0 +1,46 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package lotel_test
+
+import (
+	"io/fs"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/sdk/log/logtest"
+
+	"github.com/onsi/gomega"
+
+	. "github.com/thediveo/otelcheck/lotel"
+)
+
+func ExampleHaveException() {
+	/* only in testable example */ Ω := gomega.NewGomega(func(message string, _ ...int) { panic(message) })
+
+	err := &fs.PathError{Op: "open", Path: "/foo", Err: fs.ErrNotExist}
+	record := logtest.RecordFactory{
+		Attributes: []log.KeyValue{
+			log.String("exception.type", "*fs.PathError"),
+			log.String("exception.message", err.Error()),
+			log.String("exception.stacktrace", "goroutine 1 [running]:\n..."),
+		},
+	}.NewRecord()
+
+	Ω.Expect(record).To(BeARecord(
+		HaveException(err),
+		HaveStacktrace()))
+	Ω.Expect(record).To(HaveExceptionType(gomega.HaveSuffix("PathError")))
+	Ω.Expect(record).To(HaveExceptionMessage(gomega.ContainSubstring("does not exist")))
+	// Output:
+}
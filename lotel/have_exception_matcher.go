@@ -0,0 +1,114 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package lotel
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+
+	semconv "go.opentelemetry.io/otel/semconv/v1.37.0"
+
+	g "github.com/onsi/gomega"
+	gc "github.com/onsi/gomega/gcustom"
+	ty "github.com/onsi/gomega/types"
+)
+
+// HaveException succeeds if the actual log record has the “exception.type”
+// and “exception.message” attributes as specified by the OpenTelemetry
+// semantic conventions for the expected error. The expected exception type is
+// derived from the type of the expected error itself, not from any wrapped
+// errors, and the expected exception message is the expected error's message.
+//
+// HaveException(err) is a shorthand for:
+//
+//	BeARecord(HaveExceptionType(err), HaveExceptionMessage(err.Error()))
+//
+// Passing a nil error is reported as an error when matching.
+func HaveException(err error) ty.GomegaMatcher {
+	if err == nil {
+		return gc.MakeMatcher(func(any) (bool, error) {
+			return false, errors.New("HaveException: expected error must not be nil")
+		})
+	}
+	return BeARecord(HaveExceptionType(err), HaveExceptionMessage(err.Error()))
+}
+
+// HaveExceptionType succeeds if the actual log record has an “exception.type”
+// attribute with the expected value. The expected value can be one of the
+// following:
+//   - a string with the expected type name, such as “*fs.PathError”.
+//   - an error, deriving the expected type name from the type of the error
+//     itself, ignoring any wrapped errors; the type name is derived the same
+//     way as the OpenTelemetry trace SDK does when recording errors on spans.
+//   - a [ty.GomegaMatcher] matching the type name.
+func HaveExceptionType(expected any) ty.GomegaMatcher {
+	m := &HaveAttributeMatcher{
+		name:        string(semconv.ExceptionTypeKey),
+		value:       expected,
+		nameMatcher: g.Equal(string(semconv.ExceptionTypeKey)),
+	}
+	switch expected := expected.(type) {
+	case ty.GomegaMatcher:
+		m.valueMatcher = expected
+	case string:
+		m.valueMatcher = g.Equal(expected)
+	case error:
+		typeName := typeStr(expected)
+		m.value = typeName
+		m.valueMatcher = g.Equal(typeName)
+	default:
+		m.err = fmt.Errorf("expected exception type must be a string, error, or types.GomegaMatcher.  Got:\n%T",
+			expected)
+	}
+	return m
+}
+
+// HaveExceptionMessage succeeds if the actual log record has an
+// “exception.message” attribute with the expected value. The expected value
+// can be either a string, an error (using its message), or a
+// [ty.GomegaMatcher].
+func HaveExceptionMessage(expected any) ty.GomegaMatcher {
+	if err, ok := expected.(error); ok {
+		expected = err.Error()
+	}
+	return &HaveAttributeMatcher{
+		name:         string(semconv.ExceptionMessageKey),
+		value:        expected,
+		nameMatcher:  g.Equal(string(semconv.ExceptionMessageKey)),
+		valueMatcher: matcherOrEqual(expected),
+	}
+}
+
+// HaveStacktrace succeeds if the actual log record has a non-empty
+// “exception.stacktrace” attribute.
+func HaveStacktrace() ty.GomegaMatcher {
+	return &HaveAttributeMatcher{
+		name:         string(semconv.ExceptionStacktraceKey),
+		nameMatcher:  g.Equal(string(semconv.ExceptionStacktraceKey)),
+		valueMatcher: g.And(g.BeAssignableToTypeOf(""), g.Not(g.BeEmpty())),
+	}
+}
+
+// typeStr returns the type name of the passed value the same way as the
+// OpenTelemetry trace SDK does when recording errors on spans.
+func typeStr(i any) string {
+	t := reflect.TypeOf(i)
+	if t.PkgPath() == "" && t.Name() == "" {
+		// Likely a builtin type.
+		return t.String()
+	}
+	return fmt.Sprintf("%s.%s", t.PkgPath(), t.Name())
+}
@@ -0,0 +1,112 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package lotel
+
+import (
+	"errors"
+	"fmt"
+	"io/fs"
+
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/sdk/log/logtest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	ty "github.com/onsi/gomega/types"
+	. "github.com/thediveo/otelcheck/x/iff"
+)
+
+type customError struct{}
+
+func (customError) Error() string { return "D'OH!" }
+
+// exceptionRecord returns a log record with exception attributes for the
+// passed error.
+func exceptionRecord(err error, stacktrace string) any {
+	attrs := []log.KeyValue{
+		log.String("exception.type", typeStr(err)),
+		log.String("exception.message", err.Error()),
+	}
+	if stacktrace != "" {
+		attrs = append(attrs, log.String("exception.stacktrace", stacktrace))
+	}
+	return logtest.RecordFactory{Attributes: attrs}.NewRecord()
+}
+
+var _ = Describe("exception matchers", func() {
+
+	pathErr := &fs.PathError{Op: "open", Path: "/foo", Err: fs.ErrNotExist}
+
+	It("derives type names the same way as the trace SDK", func() {
+		Expect(typeStr(errors.New("D'OH!"))).To(Equal("*errors.errorString"))
+		Expect(typeStr(pathErr)).To(Equal("*fs.PathError"))
+		Expect(typeStr(customError{})).To(Equal("github.com/thediveo/otelcheck/lotel.customError"))
+	})
+
+	DescribeTable("matching exceptions, or not",
+		func(actual any, m ty.GomegaMatcher, matches bool) {
+			If(matches, Assertion.To, Assertion.NotTo)(Expect(actual), m)
+		},
+		Entry(nil, exceptionRecord(pathErr, ""), HaveException(pathErr), true),
+		Entry(nil, exceptionRecord(pathErr, ""), HaveException(customError{}), false),
+		Entry(nil, exceptionRecord(pathErr, ""), HaveException(&fs.PathError{Op: "close", Path: "/foo", Err: fs.ErrNotExist}), false),
+		Entry(nil, exceptionRecord(customError{}, ""), HaveException(customError{}), true),
+
+		Entry(nil, exceptionRecord(pathErr, ""), HaveExceptionType("*fs.PathError"), true),
+		Entry(nil, exceptionRecord(pathErr, ""), HaveExceptionType(HaveSuffix("PathError")), true),
+		Entry(nil, exceptionRecord(pathErr, ""), HaveExceptionType(pathErr), true),
+		Entry(nil, exceptionRecord(pathErr, ""), HaveExceptionType(fmt.Errorf("wrapped: %w", pathErr)), false),
+		Entry(nil, exceptionRecord(pathErr, ""), HaveExceptionType(errors.Join(customError{}, pathErr)), false),
+		Entry(nil, exceptionRecord(errors.New("D'OH!"), ""), HaveExceptionType(fmt.Errorf("wrapped: %w", pathErr)), false),
+		Entry(nil, exceptionRecord(fmt.Errorf("wrapped: %w", pathErr), ""), HaveExceptionType(fmt.Errorf("wrapped: %w", pathErr)), true),
+		Entry(nil, exceptionRecord(pathErr, ""), HaveExceptionType(customError{}), false),
+
+		Entry(nil, exceptionRecord(pathErr, ""), HaveExceptionMessage("open /foo: file does not exist"), true),
+		Entry(nil, exceptionRecord(pathErr, ""), HaveExceptionMessage(pathErr), true),
+		Entry(nil, exceptionRecord(pathErr, ""), HaveExceptionMessage(ContainSubstring("not exist")), true),
+		Entry(nil, exceptionRecord(pathErr, ""), HaveExceptionMessage("D'OH!"), false),
+
+		Entry(nil, exceptionRecord(pathErr, "goroutine 1 [running]:"), HaveStacktrace(), true),
+		Entry(nil, exceptionRecord(pathErr, ""), HaveStacktrace(), false),
+
+		Entry(nil, exceptionRecord(pathErr, "goroutine 1 [running]:"),
+			BeARecord(HaveException(pathErr), HaveStacktrace()), true),
+	)
+
+	It("returns errors", func() {
+		r := exceptionRecord(pathErr, "")
+		Expect(HaveException(nil).Match(r)).Error().To(
+			MatchError("HaveException: expected error must not be nil"))
+		Expect(BeARecord(HaveException(nil)).Match(logtest.RecordFactory{}.NewRecord())).Error().To(
+			MatchError("HaveException: expected error must not be nil"))
+		Expect(HaveExceptionType(nil).Match(r)).Error().To(
+			MatchError(ContainSubstring("expected exception type must be a string, error, or types.GomegaMatcher")))
+		Expect(HaveExceptionType(42).Match(r)).Error().To(
+			MatchError(ContainSubstring("Got:\nint")))
+		Expect(BeARecord(HaveExceptionType(42)).Match(logtest.RecordFactory{}.NewRecord())).Error().To(
+			HaveOccurred())
+	})
+
+	It("returns failure messages", func() {
+		r := exceptionRecord(customError{}, "")
+		Expect(HaveExceptionType(pathErr).FailureMessage(r)).To(
+			MatchRegexp(`(?s)key:.*exception\.type.*value:\n\s+<string>: \*fs\.PathError$`))
+		m := HaveException(pathErr)
+		Expect(m.Match(r)).To(BeFalse())
+		Expect(m.FailureMessage(r)).To(
+			ContainSubstring("but the following attribute expectations were not met:"))
+	})
+
+})
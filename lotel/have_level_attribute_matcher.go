@@ -0,0 +1,83 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package lotel
+
+import (
+	ty "github.com/onsi/gomega/types"
+)
+
+// HaveRecordAttribute succeeds if an OpenTelemetry log record has an attribute
+// with the specified key/name (and optional value) at the log record level,
+// ignoring resource and instrumentation/scope-level attributes. The attr
+// parameter works exactly as with [HaveAttribute].
+//
+// See also [HaveRecordAttributeWithValue], [HaveScopeAttribute], and
+// [HaveResourceAttribute].
+func HaveRecordAttribute(attr any) ty.GomegaMatcher {
+	return atLevels(HaveAttribute(attr), recordLevel)
+}
+
+// HaveRecordAttributeWithValue succeeds if an OpenTelemetry log record has an
+// attribute with the specified key/name and value at the log record level,
+// ignoring resource and instrumentation/scope-level attributes. The name and
+// value parameters work exactly as with [HaveAttributeWithValue].
+func HaveRecordAttributeWithValue(name, value any) ty.GomegaMatcher {
+	return atLevels(HaveAttributeWithValue(name, value), recordLevel)
+}
+
+// HaveScopeAttribute succeeds if an OpenTelemetry log record has an
+// instrumentation/scope-level attribute with the specified key/name (and
+// optional value), ignoring resource and log record attributes. The attr
+// parameter works exactly as with [HaveAttribute].
+//
+// See also [HaveScopeAttributeWithValue], [HaveRecordAttribute], and
+// [HaveResourceAttribute].
+func HaveScopeAttribute(attr any) ty.GomegaMatcher {
+	return atLevels(HaveAttribute(attr), scopeLevel)
+}
+
+// HaveScopeAttributeWithValue succeeds if an OpenTelemetry log record has an
+// instrumentation/scope-level attribute with the specified key/name and value,
+// ignoring resource and log record attributes. The name and value parameters
+// work exactly as with [HaveAttributeWithValue].
+func HaveScopeAttributeWithValue(name, value any) ty.GomegaMatcher {
+	return atLevels(HaveAttributeWithValue(name, value), scopeLevel)
+}
+
+// HaveResourceAttribute succeeds if an OpenTelemetry log record has a resource
+// attribute with the specified key/name (and optional value), ignoring
+// instrumentation/scope-level and log record attributes. The attr parameter
+// works exactly as with [HaveAttribute].
+//
+// See also [HaveResourceAttributeWithValue], [HaveRecordAttribute], and
+// [HaveScopeAttribute].
+func HaveResourceAttribute(attr any) ty.GomegaMatcher {
+	return atLevels(HaveAttribute(attr), resourceLevel)
+}
+
+// HaveResourceAttributeWithValue succeeds if an OpenTelemetry log record has a
+// resource attribute with the specified key/name and value, ignoring
+// instrumentation/scope-level and log record attributes. The name and value
+// parameters work exactly as with [HaveAttributeWithValue].
+func HaveResourceAttributeWithValue(name, value any) ty.GomegaMatcher {
+	return atLevels(HaveAttributeWithValue(name, value), resourceLevel)
+}
+
+// atLevels restricts the passed attribute matcher to the specified levels and
+// returns it.
+func atLevels(m ty.GomegaMatcher, levels attributeLevel) ty.GomegaMatcher {
+	m.(*HaveAttributeMatcher).levels = levels
+	return m
+}
@@ -0,0 +1,122 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package lotel
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/log/logtest"
+	"go.opentelemetry.io/otel/sdk/resource"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	ty "github.com/onsi/gomega/types"
+	. "github.com/thediveo/otelcheck/x/iff"
+)
+
+var _ = Describe("level-restricted attribute matchers", func() {
+
+	DescribeTable("matches attributes only at the specified level",
+		func(m ty.GomegaMatcher, match bool) {
+			r := logtest.RecordFactory{
+				Resource: resource.NewSchemaless(
+					attribute.String("foo", "resource"),
+					attribute.String("service.name", "foobar")),
+				InstrumentationScope: &instrumentation.Scope{
+					Attributes: attribute.NewSet(
+						attribute.String("foo", "scope"),
+						attribute.Int("scope.id", 42)),
+				},
+				Attributes: []log.KeyValue{
+					log.String("foo", "record"),
+					log.Int("bar", 666),
+				},
+			}.NewRecord()
+			If(match, Assertion.To, Assertion.NotTo)(Expect(r), m)
+			If(match, Assertion.To, Assertion.NotTo)(Expect(r), BeARecord(m))
+		},
+		Entry(nil, HaveRecordAttribute("foo=record"), true),
+		Entry(nil, HaveRecordAttribute("foo=scope"), false),
+		Entry(nil, HaveRecordAttribute("foo=resource"), false),
+		Entry(nil, HaveRecordAttribute("service.name"), false),
+		Entry(nil, HaveRecordAttributeWithValue("bar", 666), true),
+		Entry(nil, HaveRecordAttributeWithValue("scope.id", 42), false),
+
+		Entry(nil, HaveScopeAttribute("foo=scope"), true),
+		Entry(nil, HaveScopeAttribute("foo=record"), false),
+		Entry(nil, HaveScopeAttribute("foo=resource"), false),
+		Entry(nil, HaveScopeAttributeWithValue("scope.id", 42), true),
+		Entry(nil, HaveScopeAttributeWithValue("bar", 666), false),
+
+		Entry(nil, HaveResourceAttribute("foo=resource"), true),
+		Entry(nil, HaveResourceAttribute("foo=scope"), false),
+		Entry(nil, HaveResourceAttribute("foo=record"), false),
+		Entry(nil, HaveResourceAttributeWithValue("service.name", "foobar"), true),
+		Entry(nil, HaveResourceAttributeWithValue("scope.id", 42), false),
+	)
+
+	It("combines level-restricted attribute matchers", func() {
+		r := logtest.RecordFactory{
+			Resource:   resource.NewSchemaless(attribute.String("foo", "bar")),
+			Attributes: []log.KeyValue{log.String("foo", "bar")},
+		}.NewRecord()
+		Expect(r).To(BeARecord(
+			HaveResourceAttribute("foo=bar"),
+			HaveRecordAttribute("foo=bar")))
+		Expect(r).NotTo(BeARecord(
+			HaveResourceAttribute("foo=bar"),
+			HaveScopeAttribute("foo=bar")))
+	})
+
+	DescribeTable("matches attribute values the same at all levels",
+		func(rattr attribute.KeyValue, lattr log.KeyValue, value any) {
+			Expect(logtest.RecordFactory{
+				Resource: resource.NewSchemaless(rattr),
+			}.NewRecord()).To(BeARecord(HaveResourceAttributeWithValue("foo", value)))
+			Expect(logtest.RecordFactory{
+				InstrumentationScope: &instrumentation.Scope{Attributes: attribute.NewSet(rattr)},
+			}.NewRecord()).To(BeARecord(HaveScopeAttributeWithValue("foo", value)))
+			Expect(logtest.RecordFactory{
+				Attributes: []log.KeyValue{lattr},
+			}.NewRecord()).To(BeARecord(HaveRecordAttributeWithValue("foo", value)))
+		},
+		Entry("int",
+			attribute.Int("foo", 42), log.Int("foo", 42),
+			42),
+		Entry("float",
+			attribute.Float64("foo", 1.5), log.Float64("foo", 1.5),
+			1.5),
+		Entry("int slice",
+			attribute.Int64Slice("foo", []int64{1, 2}),
+			log.Slice("foo", log.Int64Value(1), log.Int64Value(2)),
+			[]int{1, 2}),
+		Entry("string slice",
+			attribute.StringSlice("foo", []string{"bar"}),
+			log.Slice("foo", log.StringValue("bar")),
+			ContainElement("bar")),
+	)
+
+	It("reports the level in failure messages", func() {
+		r := logtest.RecordFactory{}.NewRecord()
+		Expect(HaveRecordAttribute("foo").FailureMessage(r)).To(
+			MatchRegexp(`level:\s+record`))
+		Expect(HaveAttribute("foo").FailureMessage(r)).NotTo(
+			ContainSubstring("level:"))
+		Expect((resourceLevel | scopeLevel).String()).To(Equal("resource, scope"))
+		Expect(allLevels.String()).To(Equal("any"))
+	})
+
+})
@@ -32,3 +32,15 @@ func ExampleHaveSeverity() {
 	Ω.Expect(record).To(HaveSeverity(gomega.BeNumerically(">=", log.SeverityError)))
 	// Output:
 }
+
+func ExampleHaveSeverityAtLeast() {
+	/* only in testable example */ Ω := gomega.NewGomega(func(message string, _ ...int) { panic(message) })
+
+	record := logtest.RecordFactory{Severity: log.SeverityError2}.NewRecord()
+
+	Ω.Expect(record).To(HaveSeverityAtLeast(log.SeverityWarn))
+	Ω.Expect(record).To(HaveSeverityBetween(log.SeverityWarn, log.SeverityError4))
+	Ω.Expect(record).To(BeError())
+	Ω.Expect(record).NotTo(BeFatal())
+	// Output:
+}
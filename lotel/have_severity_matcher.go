@@ -24,6 +24,10 @@ import (
 // HaveSeverity succeeds if the actual log record has the expected severity
 // level. The expected severity level can either be a [log.Severity] or
 // alternatively a [ty.GomegaMatcher].
+//
+// To match ranges of severity levels, use [HaveSeverityAtLeast],
+// [HaveSeverityAtMost], and [HaveSeverityBetween], or the severity class
+// matchers such as [BeWarn] and [BeError] instead.
 func HaveSeverity(expected any) ty.GomegaMatcher {
 	m := matcherOrEqual(expected)
 	return gc.MakeMatcher(func(r sdklog.Record) (bool, error) {
@@ -0,0 +1,156 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package lotel
+
+import (
+	"errors"
+	"fmt"
+
+	"go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+
+	"github.com/onsi/gomega/format"
+	ty "github.com/onsi/gomega/types"
+)
+
+// HaveSeverityAtLeast succeeds if the actual log record has a severity level
+// that is at least as severe as the specified severity level. Log records
+// with an undefined severity never match.
+func HaveSeverityAtLeast(severity log.Severity) ty.GomegaMatcher {
+	return &SeverityRangeMatcher{
+		min:  severity,
+		max:  log.SeverityFatal4,
+		desc: "at least " + severity.String(),
+	}
+}
+
+// HaveSeverityAtMost succeeds if the actual log record has a severity level
+// that is at most as severe as the specified severity level. Log records with
+// an undefined severity never match.
+func HaveSeverityAtMost(severity log.Severity) ty.GomegaMatcher {
+	return &SeverityRangeMatcher{
+		min:  log.SeverityTrace1,
+		max:  severity,
+		desc: "at most " + severity.String(),
+	}
+}
+
+// HaveSeverityBetween succeeds if the actual log record has a severity level
+// between the specified lowest and highest severity levels, inclusive. Log
+// records with an undefined severity never match.
+func HaveSeverityBetween(lowest, highest log.Severity) ty.GomegaMatcher {
+	return &SeverityRangeMatcher{
+		min:  lowest,
+		max:  highest,
+		desc: "between " + lowest.String() + " and " + highest.String(),
+	}
+}
+
+// BeTrace succeeds if the actual log record has a severity level in the TRACE
+// class, that is, any of TRACE to TRACE4.
+func BeTrace() ty.GomegaMatcher {
+	return severityClass(log.SeverityTrace1, log.SeverityTrace4)
+}
+
+// BeDebug succeeds if the actual log record has a severity level in the DEBUG
+// class, that is, any of DEBUG to DEBUG4.
+func BeDebug() ty.GomegaMatcher {
+	return severityClass(log.SeverityDebug1, log.SeverityDebug4)
+}
+
+// BeInfo succeeds if the actual log record has a severity level in the INFO
+// class, that is, any of INFO to INFO4.
+func BeInfo() ty.GomegaMatcher {
+	return severityClass(log.SeverityInfo1, log.SeverityInfo4)
+}
+
+// BeWarn succeeds if the actual log record has a severity level in the WARN
+// class, that is, any of WARN to WARN4.
+func BeWarn() ty.GomegaMatcher {
+	return severityClass(log.SeverityWarn1, log.SeverityWarn4)
+}
+
+// BeError succeeds if the actual log record has a severity level in the ERROR
+// class, that is, any of ERROR to ERROR4.
+func BeError() ty.GomegaMatcher {
+	return severityClass(log.SeverityError1, log.SeverityError4)
+}
+
+// BeFatal succeeds if the actual log record has a severity level in the FATAL
+// class, that is, any of FATAL to FATAL4.
+func BeFatal() ty.GomegaMatcher {
+	return severityClass(log.SeverityFatal1, log.SeverityFatal4)
+}
+
+// severityClass returns a matcher for the severity class (bucket) ranging from
+// the specified lowest to highest severity level.
+func severityClass(lowest, highest log.Severity) ty.GomegaMatcher {
+	return &SeverityRangeMatcher{
+		min:  lowest,
+		max:  highest,
+		desc: fmt.Sprintf("in the %s class (%s to %s)", lowest, lowest, highest),
+	}
+}
+
+// SeverityRangeMatcher matches the severity level of a [sdklog.Record] against
+// an inclusive range of severity levels, reporting severity levels by their
+// names instead of raw numbers.
+//
+// See also: [HaveSeverityAtLeast], [HaveSeverityAtMost],
+// [HaveSeverityBetween], as well as the severity class matchers [BeTrace],
+// [BeDebug], [BeInfo], [BeWarn], [BeError], and [BeFatal].
+type SeverityRangeMatcher struct {
+	min, max log.Severity
+	desc     string
+}
+
+var _ ty.GomegaMatcher = (*SeverityRangeMatcher)(nil)
+
+func (m *SeverityRangeMatcher) Match(actual any) (success bool, err error) {
+	if actual == nil {
+		return false, errors.New("refusing to match <nil>")
+	}
+	r, ok := actual.(sdklog.Record)
+	if !ok {
+		return false, fmt.Errorf("SeverityRangeMatcher expected actual of type <%T>.  Got:\n%s",
+			sdklog.Record{}, format.Object(actual, 1))
+	}
+	if m.min > m.max {
+		return false, fmt.Errorf("SeverityRangeMatcher: invalid severity range %s to %s",
+			m.min, m.max)
+	}
+	severity := r.Severity()
+	return severity != log.SeverityUndefined && severity >= m.min && severity <= m.max, nil
+}
+
+func (m *SeverityRangeMatcher) FailureMessage(actual any) (message string) {
+	return fmt.Sprintf("Expected\n%s\nto have a severity %s%s",
+		format.Object(actual, 1), m.desc, m.actualSeverity(actual))
+}
+
+func (m *SeverityRangeMatcher) NegatedFailureMessage(actual any) (message string) {
+	return fmt.Sprintf("Expected\n%s\nnot to have a severity %s%s",
+		format.Object(actual, 1), m.desc, m.actualSeverity(actual))
+}
+
+// actualSeverity returns the description of the severity level of the actual
+// log record, if available.
+func (m *SeverityRangeMatcher) actualSeverity(actual any) string {
+	r, ok := actual.(sdklog.Record)
+	if !ok {
+		return ""
+	}
+	return "\nbut has severity\n" + format.IndentString(r.Severity().String(), 1)
+}
@@ -0,0 +1,83 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package lotel
+
+import (
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/sdk/log/logtest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	ty "github.com/onsi/gomega/types"
+	. "github.com/thediveo/otelcheck/x/iff"
+)
+
+var _ = Describe("severity range and class matchers", func() {
+
+	DescribeTable("matching severity ranges and classes, or not",
+		func(severity log.Severity, m ty.GomegaMatcher, matches bool) {
+			r := logtest.RecordFactory{Severity: severity}.NewRecord()
+			If(matches, Assertion.To, Assertion.NotTo)(Expect(r), m)
+		},
+		Entry(nil, log.SeverityWarn, HaveSeverityAtLeast(log.SeverityWarn), true),
+		Entry(nil, log.SeverityFatal4, HaveSeverityAtLeast(log.SeverityWarn), true),
+		Entry(nil, log.SeverityInfo4, HaveSeverityAtLeast(log.SeverityWarn), false),
+		Entry(nil, log.SeverityUndefined, HaveSeverityAtLeast(log.SeverityUndefined), false),
+		Entry(nil, log.SeverityInfo, HaveSeverityAtMost(log.SeverityInfo), true),
+		Entry(nil, log.SeverityTrace, HaveSeverityAtMost(log.SeverityInfo), true),
+		Entry(nil, log.SeverityInfo2, HaveSeverityAtMost(log.SeverityInfo), false),
+		Entry(nil, log.SeverityUndefined, HaveSeverityAtMost(log.SeverityInfo), false),
+		Entry(nil, log.SeverityDebug, HaveSeverityBetween(log.SeverityDebug, log.SeverityWarn), true),
+		Entry(nil, log.SeverityWarn, HaveSeverityBetween(log.SeverityDebug, log.SeverityWarn), true),
+		Entry(nil, log.SeverityWarn2, HaveSeverityBetween(log.SeverityDebug, log.SeverityWarn), false),
+
+		Entry(nil, log.SeverityTrace3, BeTrace(), true),
+		Entry(nil, log.SeverityDebug, BeTrace(), false),
+		Entry(nil, log.SeverityDebug4, BeDebug(), true),
+		Entry(nil, log.SeverityInfo1, BeInfo(), true),
+		Entry(nil, log.SeverityInfo4, BeWarn(), false),
+		Entry(nil, log.SeverityWarn2, BeWarn(), true),
+		Entry(nil, log.SeverityError1, BeError(), true),
+		Entry(nil, log.SeverityError4, BeError(), true),
+		Entry(nil, log.SeverityFatal1, BeError(), false),
+		Entry(nil, log.SeverityFatal, BeFatal(), true),
+		Entry(nil, log.SeverityUndefined, BeTrace(), false),
+	)
+
+	It("returns errors", func() {
+		Expect(HaveSeverityAtLeast(log.SeverityWarn).Match(nil)).Error().To(HaveOccurred())
+		Expect(HaveSeverityAtLeast(log.SeverityWarn).Match(42)).Error().To(
+			MatchError(ContainSubstring("expected actual of type")))
+		Expect(HaveSeverityBetween(log.SeverityWarn, log.SeverityDebug).Match(
+			logtest.RecordFactory{}.NewRecord())).Error().To(
+			MatchError(ContainSubstring("invalid severity range WARN to DEBUG")))
+	})
+
+	It("shows severity names in failure messages", func() {
+		r := logtest.RecordFactory{Severity: log.SeverityInfo2}.NewRecord()
+		Expect(HaveSeverityAtLeast(log.SeverityWarn).FailureMessage(r)).To(
+			MatchRegexp(`to have a severity at least WARN\nbut has severity\n\s+INFO2$`))
+		Expect(HaveSeverityAtMost(log.SeverityDebug).FailureMessage(r)).To(
+			ContainSubstring("to have a severity at most DEBUG"))
+		Expect(HaveSeverityBetween(log.SeverityDebug, log.SeverityDebug4).FailureMessage(r)).To(
+			ContainSubstring("to have a severity between DEBUG and DEBUG4"))
+		Expect(BeError().FailureMessage(r)).To(
+			ContainSubstring("to have a severity in the ERROR class (ERROR to ERROR4)"))
+		Expect(BeInfo().NegatedFailureMessage(r)).To(
+			MatchRegexp(`not to have a severity in the INFO class \(INFO to INFO4\)\nbut has severity\n\s+INFO2$`))
+		Expect(BeInfo().FailureMessage(42)).NotTo(ContainSubstring("but has severity"))
+	})
+
+})
@@ -0,0 +1,124 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package logconv
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+)
+
+// FromAttribute returns the log value for the passed attribute value, as used
+// for resource and instrumentation scope attributes. Homogeneous attribute
+// slice values become log slice values, and invalid attribute values become
+// empty log values.
+//
+// Converting resource and scope attribute values into log values and then
+// any-fying them using [Any] results in the same canonical representation as
+// for log record attribute values.
+func FromAttribute(v attribute.Value) log.Value {
+	switch v.Type() {
+	case attribute.BOOL:
+		return log.BoolValue(v.AsBool())
+	case attribute.INT64:
+		return log.Int64Value(v.AsInt64())
+	case attribute.FLOAT64:
+		return log.Float64Value(v.AsFloat64())
+	case attribute.STRING:
+		return log.StringValue(v.AsString())
+	case attribute.BOOLSLICE:
+		return sliceValue(v.AsBoolSlice(), log.BoolValue)
+	case attribute.INT64SLICE:
+		return sliceValue(v.AsInt64Slice(), log.Int64Value)
+	case attribute.FLOAT64SLICE:
+		return sliceValue(v.AsFloat64Slice(), log.Float64Value)
+	case attribute.STRINGSLICE:
+		return sliceValue(v.AsStringSlice(), log.StringValue)
+	}
+	return log.Value{} // KindEmpty
+}
+
+// sliceValue returns a log slice value with the passed elements converted
+// using the passed conversion function.
+func sliceValue[E any](els []E, fn func(E) log.Value) log.Value {
+	vs := make([]log.Value, 0, len(els))
+	for _, el := range els {
+		vs = append(vs, fn(el))
+	}
+	return log.SliceValue(vs...)
+}
+
+// ToAttribute returns the attribute value for the passed log value. Slice log
+// values must be homogeneous slices of either bool, int64, float64, or string
+// values; empty slice log values become empty string slice attribute values.
+// Empty log values become invalid attribute values.
+//
+// ToAttribute returns an error for log values that cannot be represented as
+// attribute values, namely bytes and map log values, as well as heterogeneous
+// or nested slice log values.
+func ToAttribute(v log.Value) (attribute.Value, error) {
+	switch v.Kind() {
+	case log.KindEmpty:
+		return attribute.Value{}, nil
+	case log.KindBool:
+		return attribute.BoolValue(v.AsBool()), nil
+	case log.KindInt64:
+		return attribute.Int64Value(v.AsInt64()), nil
+	case log.KindFloat64:
+		return attribute.Float64Value(v.AsFloat64()), nil
+	case log.KindString:
+		return attribute.StringValue(v.AsString()), nil
+	case log.KindSlice:
+		return sliceAttribute(v.AsSlice())
+	}
+	return attribute.Value{}, fmt.Errorf("cannot represent %s log value as attribute value", v.Kind())
+}
+
+// sliceAttribute returns the attribute slice value for the passed homogeneous
+// log values.
+func sliceAttribute(vs []log.Value) (attribute.Value, error) {
+	if len(vs) == 0 {
+		return attribute.StringSliceValue([]string{}), nil
+	}
+	kind := vs[0].Kind()
+	for _, v := range vs[1:] {
+		if v.Kind() != kind {
+			return attribute.Value{}, fmt.Errorf("cannot represent heterogeneous slice log value of %s and %s elements as attribute value",
+				kind, v.Kind())
+		}
+	}
+	switch kind {
+	case log.KindBool:
+		return attribute.BoolSliceValue(sliceElements(vs, log.Value.AsBool)), nil
+	case log.KindInt64:
+		return attribute.Int64SliceValue(sliceElements(vs, log.Value.AsInt64)), nil
+	case log.KindFloat64:
+		return attribute.Float64SliceValue(sliceElements(vs, log.Value.AsFloat64)), nil
+	case log.KindString:
+		return attribute.StringSliceValue(sliceElements(vs, log.Value.AsString)), nil
+	}
+	return attribute.Value{}, fmt.Errorf("cannot represent slice log value of %s elements as attribute value", kind)
+}
+
+// sliceElements returns the passed log values converted using the passed
+// conversion function.
+func sliceElements[E any](vs []log.Value, fn func(log.Value) E) []E {
+	els := make([]E, 0, len(vs))
+	for _, v := range vs {
+		els = append(els, fn(v))
+	}
+	return els
+}
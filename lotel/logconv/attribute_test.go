@@ -0,0 +1,94 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package logconv
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("attribute value conversions", func() {
+
+	DescribeTable("converting attribute values into log values",
+		func(v attribute.Value, expected log.Value) {
+			Expect(FromAttribute(v).Equal(expected)).To(BeTrue())
+		},
+		Entry("invalid", attribute.Value{}, log.Value{}),
+		Entry("bool", attribute.BoolValue(true), log.BoolValue(true)),
+		Entry("int64", attribute.Int64Value(42), log.Int64Value(42)),
+		Entry("float64", attribute.Float64Value(1.5), log.Float64Value(1.5)),
+		Entry("string", attribute.StringValue("foo"), log.StringValue("foo")),
+		Entry("bool slice", attribute.BoolSliceValue([]bool{true, false}),
+			log.SliceValue(log.BoolValue(true), log.BoolValue(false))),
+		Entry("int64 slice", attribute.Int64SliceValue([]int64{1, 2}),
+			log.SliceValue(log.Int64Value(1), log.Int64Value(2))),
+		Entry("float64 slice", attribute.Float64SliceValue([]float64{1.5}),
+			log.SliceValue(log.Float64Value(1.5))),
+		Entry("string slice", attribute.StringSliceValue([]string{"foo", "bar"}),
+			log.SliceValue(log.StringValue("foo"), log.StringValue("bar"))),
+	)
+
+	It("canonizes attribute values the same as log values", func() {
+		Expect(Any(FromAttribute(attribute.Int64SliceValue([]int64{1, 2})))).To(
+			Equal(Any(log.SliceValue(log.Int64Value(1), log.Int64Value(2)))))
+		Expect(Any(FromAttribute(attribute.StringValue("foo")))).To(
+			Equal(Any(log.StringValue("foo"))))
+	})
+
+	DescribeTable("converting log values into attribute values",
+		func(v log.Value, expected attribute.Value) {
+			Expect(ToAttribute(v)).To(Equal(expected))
+		},
+		Entry("empty", log.Value{}, attribute.Value{}),
+		Entry("bool", log.BoolValue(true), attribute.BoolValue(true)),
+		Entry("int64", log.Int64Value(42), attribute.Int64Value(42)),
+		Entry("float64", log.Float64Value(1.5), attribute.Float64Value(1.5)),
+		Entry("string", log.StringValue("foo"), attribute.StringValue("foo")),
+		Entry("empty slice", log.SliceValue(), attribute.StringSliceValue([]string{})),
+		Entry("bool slice", log.SliceValue(log.BoolValue(true)),
+			attribute.BoolSliceValue([]bool{true})),
+		Entry("int64 slice", log.SliceValue(log.Int64Value(1), log.Int64Value(2)),
+			attribute.Int64SliceValue([]int64{1, 2})),
+		Entry("float64 slice", log.SliceValue(log.Float64Value(1.5)),
+			attribute.Float64SliceValue([]float64{1.5})),
+		Entry("string slice", log.SliceValue(log.StringValue("foo")),
+			attribute.StringSliceValue([]string{"foo"})),
+	)
+
+	DescribeTable("round-tripping attribute values",
+		func(v attribute.Value) {
+			Expect(ToAttribute(FromAttribute(v))).To(Equal(v))
+		},
+		Entry("bool", attribute.BoolValue(true)),
+		Entry("int64 slice", attribute.Int64SliceValue([]int64{1, 2})),
+		Entry("string slice", attribute.StringSliceValue([]string{"foo"})),
+	)
+
+	DescribeTable("rejecting log values without attribute representation",
+		func(v log.Value, errmsg string) {
+			Expect(ToAttribute(v)).Error().To(MatchError(ContainSubstring(errmsg)))
+		},
+		Entry("bytes", log.BytesValue([]byte{1}), "cannot represent Bytes log value"),
+		Entry("map", log.MapValue(log.String("foo", "bar")), "cannot represent Map log value"),
+		Entry("heterogeneous slice", log.SliceValue(log.IntValue(1), log.StringValue("foo")),
+			"heterogeneous slice"),
+		Entry("nested slice", log.SliceValue(log.SliceValue()),
+			"slice log value of Slice elements"),
+	)
+
+})
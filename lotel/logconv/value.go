@@ -16,7 +16,12 @@ package logconv
 
 import (
 	"fmt"
+	"math"
 	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
 
 	"go.opentelemetry.io/otel/log"
 )
@@ -82,138 +87,288 @@ func anyMap(v log.Value) map[string]any {
 // prominent observability tooling, OpenTelemetry really did overdo by a wide
 // margin.)
 //
-// Canonize panics when any value encountered that is not one of the following
-// types:
-//   - bool
-//   - int and int64
-//   - float32 and float64
-//   - string
-//   - []byte
-//   - []any
-//   - map[string]any
-//   - []bool, []int, []int64, []float32, []float64 and []string
+// All other types are canonized by first converting them into a log value
+// using [Value] and then any-fying this log value using [Any], so the same
+// (potentially lossy) conversion rules apply as documented for [Value].
+//
+// Canonize panics when encountering a value that cannot be represented as a
+// log value. Use [TryCanonize] instead to get an error in this case.
 func Canonize(v any) any {
+	c, err := TryCanonize(v)
+	if err != nil {
+		panic("logconv.Canonize: " + err.Error())
+	}
+	return c
+}
+
+// TryCanonize canonizes an any value in the same way as [Canonize], but returns
+// an error instead of panicking when encountering a value that cannot be
+// represented as a log value.
+func TryCanonize(v any) (any, error) {
 	if v == nil {
-		return nil
+		return nil, nil
 	}
 	switch v := v.(type) {
 	case bool:
-		return v
+		return v, nil
 	case int:
-		return int64(v)
+		return int64(v), nil
 	case int64:
-		return v
+		return v, nil
 	case float32:
-		return float64(v)
+		return float64(v), nil
 	case float64:
-		return v
+		return v, nil
 	case string:
-		return v
+		return v, nil
 	case []byte:
-		return v
+		return v, nil
 	case []any:
 		sl := make([]any, len(v))
 		for idx, el := range v {
-			sl[idx] = Canonize(el)
+			c, err := TryCanonize(el)
+			if err != nil {
+				return nil, err
+			}
+			sl[idx] = c
 		}
-		return sl
+		return sl, nil
 	case []bool:
 		sl := make([]any, len(v))
 		for idx := range v {
 			sl[idx] = v[idx]
 		}
-		return sl
+		return sl, nil
 	case []int:
 		sl := make([]any, len(v))
 		for idx := range v {
 			sl[idx] = int64(v[idx])
 		}
-		return sl
+		return sl, nil
 	case []int64:
 		sl := make([]any, len(v))
 		for idx := range v {
 			sl[idx] = v[idx]
 		}
-		return sl
+		return sl, nil
 	case []float32:
 		sl := make([]any, len(v))
 		for idx := range v {
 			sl[idx] = float64(v[idx])
 		}
-		return sl
+		return sl, nil
 	case []float64:
 		sl := make([]any, len(v))
 		for idx := range v {
 			sl[idx] = v[idx]
 		}
-		return sl
+		return sl, nil
 	case []string:
 		sl := make([]any, len(v))
 		for idx := range v {
 			sl[idx] = v[idx]
 		}
-		return sl
+		return sl, nil
 	case map[string]any:
 		m := make(map[string]any, len(v))
 		for key, value := range v {
-			m[key] = Canonize(value)
+			c, err := TryCanonize(value)
+			if err != nil {
+				return nil, err
+			}
+			m[key] = c
 		}
-		return m
+		return m, nil
+	}
+	lv, err := TryValue(v)
+	if err != nil {
+		return nil, err
 	}
-	panic(fmt.Sprintf("logconv.Canonize: unsupported type %T", v))
+	return Any(lv), nil
 }
 
 // Value returns the log value for the passed (any) value.
 //
-// It panics for value types not supported by OTel's log value type.
+// Besides the types directly supported by OTel's log value type, Value
+// supports the following types, where some conversions lose information:
+//   - [log.Value] is returned as is.
+//   - all signed integer widths become int64 values.
+//   - all unsigned integer widths become int64 values; values exceeding the
+//     range of int64 become decimal string values instead (lossy).
+//   - float32 becomes a float64 value.
+//   - complex64 and complex128 become string values (lossy).
+//   - [time.Time] becomes an int64 value of nanoseconds since the Unix epoch
+//     and [time.Duration] becomes an int64 value of nanoseconds, following the
+//     conventions of the OTel log bridges, such as otelslog (lossy: location
+//     and monotonic clock reading are dropped).
+//   - values implementing error become the string value of their Error method
+//     (lossy).
+//   - named types, such as “type Foo string”, are converted according to their
+//     underlying basic kind, even when implementing [fmt.Stringer].
+//   - other values implementing [fmt.Stringer], such as structs, become the
+//     string value of their String method (lossy).
+//   - slices and arrays of bytes (uint8) become bytes values, all other
+//     slices and arrays become slice values.
+//   - maps become map values, sorted by key; non-string keys get converted
+//     to strings using [fmt.Sprint] (lossy).
+//   - structs become map values with their exported fields, using the field
+//     names or alternatively the names from the fields' “json” tags. Fields
+//     tagged “json:"-"” are skipped, as are fields tagged “omitempty” that have
+//     zero values (lossy: unexported fields are dropped).
+//
+// Nil pointers become empty values. Value panics for value types not
+// supported, such as channels, functions, and pointers to types that are
+// neither an error nor a [fmt.Stringer]. Use [TryValue] instead to get an error
+// in this case.
 func Value(v any) log.Value {
+	lv, err := TryValue(v)
+	if err != nil {
+		panic("logconv.Value: " + err.Error())
+	}
+	return lv
+}
+
+// TryValue returns the log value for the passed (any) value in the same way as
+// [Value], but returns an error instead of panicking for value types not
+// supported.
+func TryValue(v any) (log.Value, error) {
 	if v == nil {
-		return log.Value{} // KindEmpty
+		return log.Value{}, nil // KindEmpty
+	}
+	rv := reflect.ValueOf(v)
+	if rv.Kind() == reflect.Pointer && rv.IsNil() {
+		return log.Value{}, nil // KindEmpty
 	}
 	switch v := v.(type) {
 	case bool:
-		return log.BoolValue(v)
+		return log.BoolValue(v), nil
 	case int:
-		return log.IntValue(v)
+		return log.IntValue(v), nil
 	case int64:
-		return log.Int64Value(v)
+		return log.Int64Value(v), nil
 	case float32:
-		return log.Float64Value(float64(v))
+		return log.Float64Value(float64(v)), nil
 	case float64:
-		return log.Float64Value(v)
+		return log.Float64Value(v), nil
 	case string:
-		return log.StringValue(v)
+		return log.StringValue(v), nil
 	case []byte:
-		return log.BytesValue(v)
+		return log.BytesValue(v), nil
+	case log.Value:
+		return v, nil
+	case time.Time:
+		return log.Int64Value(v.UnixNano()), nil
+	case time.Duration:
+		return log.Int64Value(v.Nanoseconds()), nil
+	case error:
+		return log.StringValue(v.Error()), nil
+	}
+	switch rv.Kind() {
+	case reflect.Bool:
+		return log.BoolValue(rv.Bool()), nil
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		return log.Int64Value(rv.Int()), nil
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64, reflect.Uintptr:
+		u := rv.Uint()
+		if u > math.MaxInt64 {
+			return log.StringValue(strconv.FormatUint(u, 10)), nil
+		}
+		return log.Int64Value(int64(u)), nil
+	case reflect.Float32, reflect.Float64:
+		return log.Float64Value(rv.Float()), nil
+	case reflect.Complex64, reflect.Complex128:
+		return log.StringValue(fmt.Sprint(rv.Complex())), nil
+	case reflect.String:
+		return log.StringValue(rv.String()), nil
 	}
-	switch reflect.TypeOf(v).Kind() {
-	case reflect.Slice:
-		return valueSlice(v)
+	if s, ok := v.(fmt.Stringer); ok {
+		return log.StringValue(s.String()), nil
+	}
+	switch rv.Kind() {
+	case reflect.Slice, reflect.Array:
+		return valueSlice(rv)
 	case reflect.Map:
-		return valueMap(v)
+		return valueMap(rv)
+	case reflect.Struct:
+		return valueStruct(rv)
 	}
-	panic(fmt.Sprintf("logconv.Value: unsupported type %T", v))
+	return log.Value{}, fmt.Errorf("unsupported type %T", v)
 }
 
-func valueSlice(v any) log.Value {
-	rv := reflect.ValueOf(v)
+// valueSlice returns the log value for the passed slice or array value, which
+// is a bytes value for slices and arrays of bytes, and otherwise a slice value.
+func valueSlice(rv reflect.Value) (log.Value, error) {
 	l := rv.Len()
+	if rv.Type().Elem().Kind() == reflect.Uint8 {
+		b := make([]byte, l)
+		for i := range l {
+			b[i] = byte(rv.Index(i).Uint())
+		}
+		return log.BytesValue(b), nil
+	}
 	vs := make([]log.Value, 0, l)
 	for i := range l {
-		vs = append(vs, Value(rv.Index(i).Interface()))
+		v, err := TryValue(rv.Index(i).Interface())
+		if err != nil {
+			return log.Value{}, err
+		}
+		vs = append(vs, v)
 	}
-	return log.SliceValue(vs...)
+	return log.SliceValue(vs...), nil
 }
 
-func valueMap(v any) log.Value {
-	rv := reflect.ValueOf(v)
+// valueMap returns the map log value for the passed map value, sorted by key.
+func valueMap(rv reflect.Value) (log.Value, error) {
 	kvs := make([]log.KeyValue, 0, rv.Len())
 	mit := rv.MapRange()
 	for mit.Next() {
-		kvs = append(kvs, log.KeyValue{
-			Key:   mit.Key().String(),
-			Value: Value(mit.Value().Interface()),
-		})
+		key := mit.Key()
+		var k string
+		if key.Kind() == reflect.String {
+			k = key.String()
+		} else {
+			k = fmt.Sprint(key.Interface())
+		}
+		v, err := TryValue(mit.Value().Interface())
+		if err != nil {
+			return log.Value{}, err
+		}
+		kvs = append(kvs, log.KeyValue{Key: k, Value: v})
+	}
+	slices.SortFunc(kvs, func(a, b log.KeyValue) int {
+		return strings.Compare(a.Key, b.Key)
+	})
+	return log.MapValue(kvs...), nil
+}
+
+// valueStruct returns the map log value for the exported fields of the passed
+// struct value, honoring “json” field tags.
+func valueStruct(rv reflect.Value) (log.Value, error) {
+	rt := rv.Type()
+	kvs := make([]log.KeyValue, 0, rt.NumField())
+	for i := range rt.NumField() {
+		field := rt.Field(i)
+		if !field.IsExported() {
+			continue
+		}
+		name := field.Name
+		if tag, ok := field.Tag.Lookup("json"); ok {
+			tagName, opts, _ := strings.Cut(tag, ",")
+			if tagName == "-" && opts == "" {
+				continue
+			}
+			if tagName != "" {
+				name = tagName
+			}
+			if slices.Contains(strings.Split(opts, ","), "omitempty") && rv.Field(i).IsZero() {
+				continue
+			}
+		}
+		v, err := TryValue(rv.Field(i).Interface())
+		if err != nil {
+			return log.Value{}, err
+		}
+		kvs = append(kvs, log.KeyValue{Key: name, Value: v})
 	}
-	return log.MapValue(kvs...)
+	return log.MapValue(kvs...), nil
 }
@@ -15,7 +15,11 @@
 package logconv
 
 import (
+	"errors"
+	"math"
+	"net"
 	"reflect"
+	"time"
 
 	"go.opentelemetry.io/otel/log"
 
@@ -24,6 +28,27 @@ import (
 	. "github.com/thediveo/otelcheck/x/iff"
 )
 
+type namedString string
+
+type blob []byte
+
+type stringer struct{ s string }
+
+func (s stringer) String() string { return s.s }
+
+type severity int
+
+func (severity) String() string { return "severe" }
+
+type payload struct {
+	Name     string `json:"name"`
+	Count    int
+	Skipped  string `json:"-"`
+	Empty    string `json:"empty,omitempty"`
+	Nested   map[int]string
+	internal string
+}
+
 var _ = Describe("log (key-)value conversions", func() {
 
 	DescribeTable("any-fying log values",
@@ -73,6 +98,60 @@ var _ = Describe("log (key-)value conversions", func() {
 		Entry("[]string", []string{"foo", "bar"}, []any{"foo", "bar"}),
 	)
 
+	DescribeTable("canonizing further Go types",
+		func(v any, expected any) {
+			Expect(Canonize(v)).To(If(expected == nil, BeNil(), Equal(expected)))
+		},
+		Entry("int8", int8(-42), int64(-42)),
+		Entry("int16", int16(-42), int64(-42)),
+		Entry("int32", int32(-42), int64(-42)),
+		Entry("uint", uint(42), int64(42)),
+		Entry("uint8", uint8(42), int64(42)),
+		Entry("uint16", uint16(42), int64(42)),
+		Entry("uint32", uint32(42), int64(42)),
+		Entry("uint64", uint64(42), int64(42)),
+		Entry("uint64 overflow", uint64(math.MaxUint64), "18446744073709551615"),
+		Entry("complex", complex(1, 2), "(1+2i)"),
+		Entry("time.Time", time.Unix(1, 42), int64(1_000_000_042)),
+		Entry("time.Duration", 42*time.Millisecond, int64(42_000_000)),
+		Entry("error", errors.New("DOH!"), "DOH!"),
+		Entry("named string", namedString("foo"), "foo"),
+		Entry("named int with Stringer", severity(42), int64(42)),
+		Entry("Stringer", stringer{"foo"}, "foo"),
+		Entry("named byte slice", blob{127, 0, 0, 1}, []byte{127, 0, 0, 1}),
+		Entry("named byte slice with Stringer", net.IP{127, 0, 0, 1}, "127.0.0.1"),
+		Entry("byte array", [2]byte{1, 2}, []byte{1, 2}),
+		Entry("[]uint32", []uint32{1, 2}, []any{int64(1), int64(2)}),
+		Entry("[]namedString", []namedString{"foo"}, []any{"foo"}),
+		Entry("map[string]string", map[string]string{"foo": "bar"}, map[string]any{"foo": "bar"}),
+		Entry("map[int]bool", map[int]bool{42: true}, map[string]any{"42": true}),
+		Entry("nil pointer", (*stringer)(nil), nil),
+		Entry("struct", payload{
+			Name:     "foo",
+			Count:    42,
+			Skipped:  "skipped",
+			Nested:   map[int]string{1: "one"},
+			internal: "internal",
+		}, map[string]any{
+			"name":   "foo",
+			"Count":  int64(42),
+			"Nested": map[string]any{"1": "one"},
+		}),
+	)
+
+	It("converts map keys deterministically", func() {
+		v := Value(map[string]int{"c": 3, "a": 1, "b": 2})
+		keys := []string{}
+		for _, kv := range v.AsMap() {
+			keys = append(keys, kv.Key)
+		}
+		Expect(keys).To(Equal([]string{"a", "b", "c"}))
+	})
+
+	It("passes log values through", func() {
+		Expect(Value(log.StringValue("foo")).Equal(log.StringValue("foo"))).To(BeTrue())
+	})
+
 	It("panics when canonizing fails", func() {
 		Expect(func() {
 			_ = Canonize(make(chan struct{}))
@@ -108,6 +187,21 @@ var _ = Describe("log (key-)value conversions", func() {
 		}).To(PanicWith("logconv.Value: unsupported type *chan struct {}"))
 	})
 
+	It("returns errors instead of panicking", func() {
+		Expect(TryValue(make(chan struct{}))).Error().To(
+			MatchError("unsupported type chan struct {}"))
+		Expect(TryValue([]any{42, func() {}})).Error().To(
+			MatchError("unsupported type func()"))
+		Expect(TryValue(map[string]any{"foo": make(chan int)})).Error().To(HaveOccurred())
+		Expect(TryValue(struct{ Foo chan int }{})).Error().To(HaveOccurred())
+		Expect(TryCanonize(make(chan struct{}))).Error().To(HaveOccurred())
+		Expect(TryCanonize([]any{42, make(chan struct{})})).Error().To(HaveOccurred())
+		Expect(TryCanonize(map[string]any{"foo": make(chan struct{})})).Error().To(HaveOccurred())
+
+		Expect(TryValue(42)).To(Equal(log.IntValue(42)))
+		Expect(TryCanonize([]int{42})).To(Equal([]any{int64(42)}))
+	})
+
 	It("equals", func() {
 		mv := Value(map[string]string{
 			"foo": "bar",
@@ -0,0 +1,213 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package lotel
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+
+	"github.com/thediveo/otelcheck/exporters/chanlog"
+
+	"github.com/onsi/gomega/format"
+)
+
+var (
+	prettyFormatMu  sync.Mutex
+	prettyFormatKey format.CustomFormatterKey // non-zero if enabled
+)
+
+// EnablePrettyFormat registers a custom Gomega formatter that renders
+// [sdklog.Record], [log.Value], [log.KeyValue], and [chanlog.RecordsChannel]
+// values in a compact, logfmt-like form in failure messages, instead of dumping
+// their internal structure. Log records are rendered with their resource,
+// instrumentation scope, and record levels labelled. Enabling more than once
+// has no further effect.
+//
+// Typically, EnablePrettyFormat is called once in a test suite, such as:
+//
+//	var _ = BeforeSuite(func() {
+//	    lotel.EnablePrettyFormat()
+//	    DeferCleanup(lotel.DisablePrettyFormat)
+//	})
+func EnablePrettyFormat() {
+	prettyFormatMu.Lock()
+	defer prettyFormatMu.Unlock()
+	if prettyFormatKey != 0 {
+		return
+	}
+	prettyFormatKey = format.RegisterCustomFormatter(prettyFormat)
+}
+
+// DisablePrettyFormat unregisters the custom Gomega formatter registered by
+// [EnablePrettyFormat]. It does nothing if pretty formatting isn't enabled.
+func DisablePrettyFormat() {
+	prettyFormatMu.Lock()
+	defer prettyFormatMu.Unlock()
+	if prettyFormatKey == 0 {
+		return
+	}
+	format.UnregisterCustomFormatter(prettyFormatKey)
+	prettyFormatKey = 0
+}
+
+// prettyFormat is the custom Gomega formatter for OTel log record-related
+// values.
+func prettyFormat(value any) (string, bool) {
+	switch value := value.(type) {
+	case sdklog.Record:
+		return prettyRecord(&value), true
+	case *sdklog.Record:
+		return prettyRecord(value), true
+	case log.Value:
+		return prettyValue(value), true
+	case log.KeyValue:
+		return value.Key + "=" + prettyValue(value.Value), true
+	case chanlog.RecordsChannel:
+		return fmt.Sprintf("chanlog.RecordsChannel (len:%d, cap:%d)", len(value), cap(value)), true
+	}
+	return "", false
+}
+
+// prettyRecord renders the passed log record in a compact, logfmt-like
+// multi-line form, omitting unset fields. The field lines are not indented
+// themselves, as Gomega already indents all but the first line of custom
+// formatter output to the nesting level of the value being formatted. As
+// Gomega indents a trailing line the same way, the closing brace follows the
+// last field instead of being on a line of its own.
+func prettyRecord(r *sdklog.Record) string {
+	var sb strings.Builder
+	sb.WriteString("sdklog.Record{")
+	field := func(name, value string) {
+		sb.WriteString("\n" + name + ": " + value)
+	}
+	if ts := r.Timestamp(); !ts.IsZero() {
+		field("timestamp", ts.Format(time.RFC3339Nano))
+	}
+	if ts := r.ObservedTimestamp(); !ts.IsZero() {
+		field("observed", ts.Format(time.RFC3339Nano))
+	}
+	if sev := r.Severity(); sev != 0 || r.SeverityText() != "" {
+		s := fmt.Sprintf("%s (%d)", sev, sev)
+		if text := r.SeverityText(); text != "" {
+			s += " " + strconv.Quote(text)
+		}
+		field("severity", s)
+	}
+	if name := r.EventName(); name != "" {
+		field("event", strconv.Quote(name))
+	}
+	if body := r.Body(); body.Kind() != log.KindEmpty {
+		field("body", prettyValue(body))
+	}
+	if traceID := r.TraceID(); traceID.IsValid() {
+		field("trace", fmt.Sprintf("id=%s span=%s flags=%s",
+			traceID, r.SpanID(), r.TraceFlags()))
+	}
+	if r.AttributesLen() > 0 {
+		attrs := make([]string, 0, r.AttributesLen())
+		for attr := range r.WalkAttributes {
+			attrs = append(attrs, attr.Key+"="+prettyValue(attr.Value))
+		}
+		field("record", strings.Join(attrs, " "))
+	}
+	scope := r.InstrumentationScope()
+	if scope.Name != "" || scope.Version != "" || scope.SchemaURL != "" || scope.Attributes.Len() > 0 {
+		var s []string
+		if scope.Name != "" {
+			s = append(s, "name="+strconv.Quote(scope.Name))
+		}
+		if scope.Version != "" {
+			s = append(s, "version="+strconv.Quote(scope.Version))
+		}
+		if scope.SchemaURL != "" {
+			s = append(s, "schemaURL="+strconv.Quote(scope.SchemaURL))
+		}
+		if scope.Attributes.Len() > 0 {
+			s = append(s, "| "+prettyAttributeSet(&scope.Attributes))
+		}
+		field("scope", strings.Join(s, " "))
+	}
+	if res := r.Resource(); res != nil && res.Len() > 0 {
+		field("resource", prettyAttributeSet(res.Set()))
+	}
+	sb.WriteString("}")
+	return sb.String()
+}
+
+// prettyAttributeSet renders the attributes of the passed set in logfmt form.
+func prettyAttributeSet(attrs *attribute.Set) string {
+	s := make([]string, 0, attrs.Len())
+	it := attrs.Iter()
+	for it.Next() {
+		attr := it.Attribute()
+		s = append(s, string(attr.Key)+"="+prettyAttributeValue(attr.Value))
+	}
+	return strings.Join(s, " ")
+}
+
+// prettyAttributeValue renders the passed attribute value, quoting strings.
+func prettyAttributeValue(v attribute.Value) string {
+	switch v.Type() {
+	case attribute.STRING:
+		return strconv.Quote(v.AsString())
+	case attribute.STRINGSLICE:
+		s := v.AsStringSlice()
+		qs := make([]string, 0, len(s))
+		for _, el := range s {
+			qs = append(qs, strconv.Quote(el))
+		}
+		return "[" + strings.Join(qs, ", ") + "]"
+	}
+	return v.Emit()
+}
+
+// prettyValue renders the passed log value in a compact JSON-like form,
+// quoting strings.
+func prettyValue(v log.Value) string {
+	switch v.Kind() {
+	case log.KindBool:
+		return strconv.FormatBool(v.AsBool())
+	case log.KindInt64:
+		return strconv.FormatInt(v.AsInt64(), 10)
+	case log.KindFloat64:
+		return strconv.FormatFloat(v.AsFloat64(), 'g', -1, 64)
+	case log.KindString:
+		return strconv.Quote(v.AsString())
+	case log.KindBytes:
+		return fmt.Sprintf("0x%x", v.AsBytes())
+	case log.KindSlice:
+		vs := v.AsSlice()
+		s := make([]string, 0, len(vs))
+		for _, el := range vs {
+			s = append(s, prettyValue(el))
+		}
+		return "[" + strings.Join(s, ", ") + "]"
+	case log.KindMap:
+		kvs := v.AsMap()
+		s := make([]string, 0, len(kvs))
+		for _, kv := range kvs {
+			s = append(s, kv.Key+"="+prettyValue(kv.Value))
+		}
+		return "{" + strings.Join(s, ", ") + "}"
+	}
+	return "<empty>"
+}
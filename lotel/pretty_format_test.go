@@ -0,0 +1,121 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package lotel
+
+import (
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/sdk/log/logtest"
+	"go.opentelemetry.io/otel/sdk/resource"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/thediveo/otelcheck/exporters/chanlog"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/format"
+)
+
+var _ = Describe("pretty formatting", func() {
+
+	BeforeEach(func() {
+		EnablePrettyFormat()
+		EnablePrettyFormat()
+		DeferCleanup(func() {
+			DisablePrettyFormat()
+			DisablePrettyFormat()
+		})
+	})
+
+	It("formats log records", func() {
+		r := logtest.RecordFactory{
+			Timestamp:    time.Unix(0, 0).UTC(),
+			Severity:     log.SeverityWarn,
+			SeverityText: "WARN",
+			Body:         log.StringValue("DOH!"),
+			EventName:    "org.foo",
+			TraceID:      trace.TraceID{1},
+			SpanID:       trace.SpanID{2},
+			TraceFlags:   trace.FlagsSampled,
+			Resource:     resource.NewSchemaless(attribute.String("service.name", "foobar")),
+			InstrumentationScope: &instrumentation.Scope{
+				Name:       "ourpkg",
+				Version:    "v1.2.3",
+				Attributes: attribute.NewSet(attribute.StringSlice("tags", []string{"a", "b"})),
+			},
+			Attributes: []log.KeyValue{
+				log.Int("answer", 42),
+				log.Map("m", log.Bool("b", true), log.Float64("f", 1.5)),
+				log.Slice("s", log.Bytes("bytes", []byte{0xde, 0xad}).Value, log.Value{}),
+			},
+		}.NewRecord()
+		Expect(format.Object(r, 0)).To(Equal(`<log.Record>: sdklog.Record{
+    timestamp: 1970-01-01T00:00:00Z
+    severity: WARN (13) "WARN"
+    event: "org.foo"
+    body: "DOH!"
+    trace: id=01000000000000000000000000000000 span=0200000000000000 flags=01
+    record: answer=42 m={b=true, f=1.5} s=[0xdead, <empty>]
+    scope: name="ourpkg" version="v1.2.3" | tags=["a", "b"]
+    resource: service.name="foobar"}`))
+		Expect(format.Object(&r, 0)).To(HavePrefix("<*log.Record | 0x"))
+	})
+
+	It("omits unset record fields", func() {
+		Expect(format.Object(logtest.RecordFactory{}.NewRecord(), 0)).To(Equal(
+			"<log.Record>: sdklog.Record{}"))
+	})
+
+	It("indents nested log records only once", func() {
+		r := logtest.RecordFactory{
+			Severity: log.SeverityWarn,
+			Body:     log.StringValue("DOH!"),
+		}.NewRecord()
+		Expect(format.Object([]sdklog.Record{r, logtest.RecordFactory{}.NewRecord()}, 0)).To(HaveSuffix(`: [
+    sdklog.Record{
+        severity: WARN (13)
+        body: "DOH!"},
+    sdklog.Record{},
+]`))
+		Expect(format.Object(struct{ R sdklog.Record }{R: r}, 0)).To(HaveSuffix(`: {
+    R: sdklog.Record{
+        severity: WARN (13)
+        body: "DOH!"},
+}`))
+	})
+
+	It("formats values and key-values", func() {
+		Expect(format.Object(log.StringValue("foo"), 0)).To(Equal(`<log.Value>: "foo"`))
+		Expect(format.Object(log.Int("foo", 42), 0)).To(Equal(`<log.KeyValue>: foo=42`))
+	})
+
+	It("formats record channels without draining them", func() {
+		ch := make(chanlog.RecordsChannel, 2)
+		ch <- logtest.RecordFactory{}.NewRecord()
+		Expect(format.Object(ch, 0)).To(HaveSuffix(
+			">: chanlog.RecordsChannel (len:1, cap:2)"))
+		Expect(ch).To(HaveLen(1))
+	})
+
+	It("can be disabled", func() {
+		DisablePrettyFormat()
+		Expect(format.Object(log.StringValue("foo"), 0)).NotTo(Equal(`<log.Value>: "foo"`))
+	})
+
+})
@@ -0,0 +1,51 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package lotel_test
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/log"
+
+	"github.com/thediveo/otelcheck/lotel"
+	"github.com/thediveo/otelcheck/lotel/testlogger"
+
+	"github.com/onsi/gomega"
+)
+
+func ExampleReceiveInOrder() {
+	/* only in testable example */ Ω := gomega.NewGomega(func(message string, _ ...int) { panic(message) })
+
+	ctx, cancel := context.WithTimeout(context.TODO(), 30*time.Second)
+	defer cancel()
+
+	logger, shutdown, ch := testlogger.New(10)
+	defer shutdown(ctx)
+
+	go func() {
+		for _, name := range []string{"org.foo", "org.bar", "org.baz"} {
+			r := log.Record{}
+			r.SetEventName(name)
+			logger.Emit(ctx, r)
+		}
+	}()
+
+	Ω.Eventually(ch).Should(lotel.ReceiveInOrder(
+		lotel.BeARecord(lotel.HaveEventName("org.foo")),
+		lotel.BeARecord(lotel.HaveEventName("org.bar")),
+		lotel.BeARecord(lotel.HaveEventName("org.baz"))))
+	// Output:
+}
@@ -0,0 +1,351 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package lotel
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+
+	"github.com/thediveo/otelcheck/exporters/chanlog"
+
+	"github.com/onsi/gomega/format"
+	ty "github.com/onsi/gomega/types"
+)
+
+// ReceiveInOrder succeeds if the actual log records match the specified
+// matchers in exactly the specified order, without any other records in
+// between.
+//
+// The actual value can be either a [chanlog.RecordsChannel] (or a plain channel
+// of [sdklog.Record]) or a slice of [sdklog.Record].
+//
+//   - When actual is a channel, ReceiveInOrder receives records only as long as
+//     they are available without blocking and stops receiving as soon as the
+//     last matcher has been satisfied, leaving any further records in the
+//     channel. ReceiveInOrder remembers the records received so far, so it
+//     can be used with [gomega.Eventually] to wait for records arriving
+//     asynchronously. A received record not matching the next matcher, as
+//     well as a closed channel, ends the asynchronous assertion early.
+//   - When actual is a slice, ReceiveInOrder requires the slice to contain
+//     exactly the expected records.
+//
+// Usage example:
+//
+//	Eventually(ch).Should(ReceiveInOrder(
+//	    BeARecord(HaveEventName("org.foo")),
+//	    BeARecord(HaveEventName("org.bar"))))
+//
+// See also [ContainInOrder] and [ConsistOfRecords].
+//
+// [gomega.Eventually]: https://pkg.go.dev/github.com/onsi/gomega#Eventually
+func ReceiveInOrder(ms ...ty.GomegaMatcher) ty.GomegaMatcher {
+	return &RecordSequenceMatcher{matchers: ms, order: inOrder}
+}
+
+// ContainInOrder succeeds if the actual log records contain records matching
+// the specified matchers in the specified order, allowing for other records in
+// between. In contrast to [ReceiveInOrder], ContainInOrder skips any records
+// not matching the next matcher.
+//
+// The actual value can be either a [chanlog.RecordsChannel] (or a plain channel
+// of [sdklog.Record]) or a slice of [sdklog.Record]. When actual is a channel,
+// ContainInOrder receives records only as long as they are available without
+// blocking and stops receiving as soon as the last matcher has been satisfied.
+// As ContainInOrder remembers its progress, it can be used with
+// [gomega.Eventually].
+//
+// See also [ReceiveInOrder] and [ConsistOfRecords].
+//
+// [gomega.Eventually]: https://pkg.go.dev/github.com/onsi/gomega#Eventually
+func ContainInOrder(ms ...ty.GomegaMatcher) ty.GomegaMatcher {
+	return &RecordSequenceMatcher{matchers: ms, order: inOrderWithGaps}
+}
+
+// ConsistOfRecords succeeds if the actual log records match the specified
+// matchers in any order, without any other records. Each matcher must be
+// satisfied by exactly one record. ConsistOfRecords finds the best possible
+// pairing of records and matchers, so overlapping matchers where a record
+// satisfies more than one matcher work as expected.
+//
+// The actual value can be either a [chanlog.RecordsChannel] (or a plain channel
+// of [sdklog.Record]) or a slice of [sdklog.Record].
+//
+//   - When actual is a channel, ConsistOfRecords receives records only as long
+//     as they are available without blocking and stops receiving as soon as
+//     all matchers have been satisfied. As ConsistOfRecords remembers its
+//     progress, it can be used with [gomega.Eventually]. A received record not
+//     matching any of the still unsatisfied matchers, as well as a closed
+//     channel, ends the asynchronous assertion early.
+//   - When actual is a slice, ConsistOfRecords requires the slice to contain
+//     exactly the expected records.
+//
+// See also [ReceiveInOrder] and [ContainInOrder].
+//
+// [gomega.Eventually]: https://pkg.go.dev/github.com/onsi/gomega#Eventually
+func ConsistOfRecords(ms ...ty.GomegaMatcher) ty.GomegaMatcher {
+	return &RecordSequenceMatcher{matchers: ms, order: anyOrder}
+}
+
+// sequenceOrder specifies how a RecordSequenceMatcher matches records against
+// its list of matchers.
+type sequenceOrder uint8
+
+const (
+	inOrder         sequenceOrder = iota // in order, no gaps
+	inOrderWithGaps                      // in order, gaps allowed
+	anyOrder                             // in any order, no gaps
+)
+
+// RecordSequenceMatcher matches a sequence of log records, either received from
+// a channel or passed as a slice, against its list of matchers. When matching
+// a channel, a RecordSequenceMatcher keeps its state between Match calls, so
+// that it can be used with asynchronous assertions.
+//
+// See also: [ReceiveInOrder], [ContainInOrder], and [ConsistOfRecords].
+type RecordSequenceMatcher struct {
+	matchers []ty.GomegaMatcher
+	order    sequenceOrder
+
+	initialized bool                 // matching state has been initialized
+	ch          <-chan sdklog.Record // channel currently being matched, if any
+	received    []sdklog.Record      // records received/seen so far
+	next        int                  // index of next matcher to satisfy (in order)
+	edges       [][]bool             // matchers satisfied by each received record (any order)
+	matched     []bool               // satisfied matchers (any order)
+	complete    bool                 // all matchers satisfied
+	final       bool                 // outcome cannot change anymore
+	failure     string               // describes why the sequence failed
+}
+
+var _ ty.GomegaMatcher = (*RecordSequenceMatcher)(nil)
+
+func (m *RecordSequenceMatcher) Match(actual any) (success bool, err error) {
+	if actual == nil {
+		return false, errors.New("refusing to match <nil>")
+	}
+	switch actual := actual.(type) {
+	case chanlog.RecordsChannel:
+		return m.matchChannel(actual)
+	case chan sdklog.Record:
+		return m.matchChannel(actual)
+	case <-chan sdklog.Record:
+		return m.matchChannel(actual)
+	case []sdklog.Record:
+		return m.matchSlice(actual)
+	}
+	return false, fmt.Errorf("%s expected actual of type <%T> or <%T>.  Got:\n%s",
+		m.name(), chanlog.RecordsChannel(nil), []sdklog.Record{}, format.Object(actual, 1))
+}
+
+// MatchMayChangeInTheFuture returns false after a definitive failure, such as
+// after having received a non-matching record or when the channel has been
+// closed, so that asynchronous assertions can end early.
+func (m *RecordSequenceMatcher) MatchMayChangeInTheFuture(actual any) bool {
+	return !m.final
+}
+
+// matchChannel receives records from the passed channel as long as they are
+// available without blocking, until either all matchers have been satisfied or
+// the sequence definitively fails. State is kept across calls as long as the
+// same channel gets passed in.
+func (m *RecordSequenceMatcher) matchChannel(ch <-chan sdklog.Record) (bool, error) {
+	if ch == nil {
+		return false, fmt.Errorf("%s refusing to match <nil> channel", m.name())
+	}
+	if !m.initialized || ch != m.ch {
+		m.reset()
+		m.ch = ch
+	}
+	for !m.complete && !m.final {
+		select {
+		case r, ok := <-ch:
+			if !ok {
+				m.final = true
+				m.failure = "channel closed before " + m.pending()
+				return false, nil
+			}
+			if err := m.step(r); err != nil {
+				return false, err
+			}
+		default:
+			m.failure = "still waiting for " + m.pending()
+			return false, nil
+		}
+	}
+	if m.complete {
+		m.failure = ""
+	}
+	return m.complete, nil
+}
+
+// matchSlice matches the records in the passed slice, always starting afresh.
+func (m *RecordSequenceMatcher) matchSlice(records []sdklog.Record) (bool, error) {
+	m.reset()
+	for idx, r := range records {
+		if m.complete {
+			if m.order == inOrderWithGaps {
+				break
+			}
+			m.received = append(m.received, records[idx:]...)
+			m.failure = fmt.Sprintf("unexpected record #%d after all matchers had been satisfied", idx)
+			return false, nil
+		}
+		if err := m.step(r); err != nil {
+			return false, err
+		}
+		if m.final {
+			return false, nil
+		}
+	}
+	if !m.complete {
+		m.failure = "missing records for " + m.pending()
+		return false, nil
+	}
+	return true, nil
+}
+
+// reset the matching state.
+func (m *RecordSequenceMatcher) reset() {
+	m.initialized = true
+	m.ch = nil
+	m.received = nil
+	m.next = 0
+	m.edges = nil
+	m.matched = make([]bool, len(m.matchers))
+	m.complete = len(m.matchers) == 0
+	m.final = false
+	m.failure = ""
+}
+
+// step matches the next record of the sequence, updating the matching state.
+func (m *RecordSequenceMatcher) step(r sdklog.Record) error {
+	idx := len(m.received)
+	m.received = append(m.received, r)
+	switch m.order {
+	case inOrder, inOrderWithGaps:
+		sm := m.matchers[m.next]
+		success, err := sm.Match(r)
+		if err != nil {
+			return err
+		}
+		if !success {
+			if m.order == inOrderWithGaps {
+				return nil
+			}
+			m.final = true
+			m.failure = fmt.Sprintf("step %d of %d failed for record #%d:\n%s",
+				m.next+1, len(m.matchers), idx, format.IndentString(sm.FailureMessage(r), 1))
+			return nil
+		}
+		m.next++
+		m.complete = m.next == len(m.matchers)
+	case anyOrder:
+		// determine the matchers the newly received record satisfies, and then
+		// pair up all records received so far with the matchers, so that
+		// overlapping matchers don't cause false negatives.
+		edges := make([]bool, len(m.matchers))
+		for midx, sm := range m.matchers {
+			success, err := sm.Match(r)
+			if err != nil {
+				return err
+			}
+			edges[midx] = success
+		}
+		m.edges = append(m.edges, edges)
+		pairing, _ := maximumMatching(len(m.received), len(m.matchers), func(l, r int) (bool, error) {
+			return m.edges[l][r], nil
+		})
+		m.matched = make([]bool, len(m.matchers))
+		m.next = 0
+		unpaired := -1
+		for ridx, midx := range pairing {
+			if midx < 0 {
+				if unpaired < 0 {
+					unpaired = ridx
+				}
+				continue
+			}
+			m.matched[midx] = true
+			m.next++
+		}
+		if unpaired < 0 {
+			m.complete = m.next == len(m.matchers)
+			return nil
+		}
+		m.final = true
+		m.failure = fmt.Sprintf("record #%d did not match any of the %s",
+			unpaired, m.pending())
+	}
+	return nil
+}
+
+// pending describes the matchers not yet satisfied.
+func (m *RecordSequenceMatcher) pending() string {
+	if m.order == anyOrder {
+		var pending []ty.GomegaMatcher
+		for midx, sm := range m.matchers {
+			if !m.matched[midx] {
+				pending = append(pending, sm)
+			}
+		}
+		return fmt.Sprintf("%d remaining matchers:\n%s",
+			len(pending), format.Object(pending, 1))
+	}
+	return fmt.Sprintf("step %d of %d:\n%s",
+		m.next+1, len(m.matchers), format.Object(m.matchers[m.next], 1))
+}
+
+// name returns the name of the matcher's constructor.
+func (m *RecordSequenceMatcher) name() string {
+	switch m.order {
+	case inOrderWithGaps:
+		return "ContainInOrder"
+	case anyOrder:
+		return "ConsistOfRecords"
+	default:
+		return "ReceiveInOrder"
+	}
+}
+
+func (m *RecordSequenceMatcher) expectation() string {
+	switch m.order {
+	case inOrderWithGaps:
+		return "contain records in order matching"
+	case anyOrder:
+		return "consist of records matching"
+	default:
+		return "receive records in order matching"
+	}
+}
+
+func (m *RecordSequenceMatcher) FailureMessage(actual any) (message string) {
+	var sb strings.Builder
+	fmt.Fprintf(&sb, "Expected\n%s\nto %s\n%s",
+		format.Object(actual, 1), m.expectation(), format.Object(m.matchers, 1))
+	if m.failure != "" {
+		fmt.Fprintf(&sb, "\nbut %s", m.failure)
+	}
+	fmt.Fprintf(&sb, "\nrecords received:\n%s", format.Object(m.received, 1))
+	return sb.String()
+}
+
+func (m *RecordSequenceMatcher) NegatedFailureMessage(actual any) (message string) {
+	return fmt.Sprintf("Expected\n%s\nnot to %s\n%s\nrecords received:\n%s",
+		format.Object(actual, 1), m.expectation(), format.Object(m.matchers, 1),
+		format.Object(m.received, 1))
+}
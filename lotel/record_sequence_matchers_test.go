@@ -0,0 +1,202 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package lotel
+
+import (
+	"time"
+
+	"go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/sdk/log/logtest"
+
+	"github.com/thediveo/otelcheck/exporters/chanlog"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	ty "github.com/onsi/gomega/types"
+	. "github.com/thediveo/otelcheck/x/iff"
+	. "github.com/thediveo/success"
+)
+
+func eventRecords(names ...string) []sdklog.Record {
+	records := make([]sdklog.Record, 0, len(names))
+	for _, name := range names {
+		records = append(records, logtest.RecordFactory{EventName: name}.NewRecord())
+	}
+	return records
+}
+
+func events(names ...string) []ty.GomegaMatcher {
+	ms := make([]ty.GomegaMatcher, 0, len(names))
+	for _, name := range names {
+		ms = append(ms, BeARecord(HaveEventName(name)))
+	}
+	return ms
+}
+
+var _ = Describe("record sequence matchers", func() {
+
+	It("rejects invalid actual values", func() {
+		Expect(ReceiveInOrder().Match(nil)).Error().To(HaveOccurred())
+		Expect(ContainInOrder().Match(42)).Error().To(
+			MatchError(ContainSubstring("ContainInOrder expected actual of type")))
+		Expect(ConsistOfRecords().Match("foo")).Error().To(
+			MatchError(ContainSubstring("ConsistOfRecords expected actual of type")))
+	})
+
+	It("rejects nil channels", func() {
+		Expect(ReceiveInOrder().Match(chanlog.RecordsChannel(nil))).Error().To(
+			MatchError(ContainSubstring("ReceiveInOrder refusing to match <nil> channel")))
+		Expect(ConsistOfRecords(events("a")...).Match(chanlog.RecordsChannel(nil))).Error().To(
+			MatchError(ContainSubstring("ConsistOfRecords refusing to match <nil> channel")))
+		Expect(ContainInOrder(events("a")...).Match((chan sdklog.Record)(nil))).Error().To(
+			HaveOccurred())
+	})
+
+	It("pairs up records with overlapping matchers in any order", func() {
+		records := []sdklog.Record{
+			logtest.RecordFactory{EventName: "x", Severity: log.SeverityInfo}.NewRecord(),
+			logtest.RecordFactory{EventName: "y", Severity: log.SeverityInfo}.NewRecord(),
+		}
+		m := ConsistOfRecords(
+			BeARecord(HaveSeverity(log.SeverityInfo)),
+			BeARecord(HaveEventName("x"), HaveSeverity(log.SeverityInfo)))
+		Expect(records).To(m)
+
+		ch := make(chanlog.RecordsChannel, 10)
+		for _, r := range records {
+			ch <- r
+		}
+		Expect(ch).To(m)
+
+		m = ConsistOfRecords(
+			BeARecord(HaveSeverity(log.SeverityInfo)),
+			BeARecord(HaveEventName("x")))
+		Expect(eventRecords("x", "x")).NotTo(m)
+		Expect(m.FailureMessage(nil)).To(ContainSubstring("did not match any of the 1 remaining matchers"))
+	})
+
+	DescribeTable("matching record slices",
+		func(newMatcher func(...ty.GomegaMatcher) ty.GomegaMatcher, actual []string, expected []string, match bool) {
+			If(match, Assertion.To, Assertion.NotTo)(
+				Expect(eventRecords(actual...)), newMatcher(events(expected...)...))
+		},
+		Entry(nil, ReceiveInOrder, []string{"a", "b", "c"}, []string{"a", "b", "c"}, true),
+		Entry(nil, ReceiveInOrder, []string{}, []string{}, true),
+		Entry(nil, ReceiveInOrder, []string{"a", "b", "c"}, []string{"a", "c"}, false),
+		Entry(nil, ReceiveInOrder, []string{"a", "b"}, []string{"a", "b", "c"}, false),
+		Entry(nil, ReceiveInOrder, []string{"b", "a"}, []string{"a", "b"}, false),
+
+		Entry(nil, ContainInOrder, []string{"a", "b", "c"}, []string{"a", "c"}, true),
+		Entry(nil, ContainInOrder, []string{"x", "a", "y", "c", "z"}, []string{"a", "c"}, true),
+		Entry(nil, ContainInOrder, []string{"c", "a"}, []string{"a", "c"}, false),
+		Entry(nil, ContainInOrder, []string{"a"}, []string{"a", "c"}, false),
+
+		Entry(nil, ConsistOfRecords, []string{"c", "a", "b"}, []string{"a", "b", "c"}, true),
+		Entry(nil, ConsistOfRecords, []string{"a", "b", "c"}, []string{"a", "b"}, false),
+		Entry(nil, ConsistOfRecords, []string{"a", "b"}, []string{"a", "b", "c"}, false),
+		Entry(nil, ConsistOfRecords, []string{"a", "x"}, []string{"a", "b"}, false),
+	)
+
+	It("reports the failing step", func() {
+		actual := eventRecords("a", "x", "c")
+		m := ReceiveInOrder(events("a", "b", "c")...)
+		Expect(Successful(m.Match(actual))).To(BeFalse())
+		Expect(m.FailureMessage(actual)).To(And(
+			ContainSubstring("to receive records in order matching"),
+			ContainSubstring("but step 2 of 3 failed for record #1"),
+			ContainSubstring("records received:")))
+
+		m = ConsistOfRecords(events("a", "b")...)
+		Expect(Successful(m.Match(actual))).To(BeFalse())
+		Expect(m.FailureMessage(actual)).To(
+			ContainSubstring("but record #1 did not match any of the 1 remaining matchers"))
+
+		m = ReceiveInOrder(events("a")...)
+		Expect(Successful(m.Match(actual))).To(BeFalse())
+		Expect(m.FailureMessage(actual)).To(
+			ContainSubstring("but unexpected record #1 after all matchers had been satisfied"))
+		Expect(m.NegatedFailureMessage(actual)).To(
+			ContainSubstring("not to receive records in order matching"))
+	})
+
+	When("receiving from channels", func() {
+
+		It("receives in order while leaving other records", func() {
+			ch := make(chanlog.RecordsChannel, 10)
+			for _, r := range eventRecords("a", "b", "c") {
+				ch <- r
+			}
+			Expect(ch).To(ReceiveInOrder(events("a", "b")...))
+			Expect(ch).To(HaveLen(1))
+		})
+
+		It("waits for records arriving asynchronously", func() {
+			ch := make(chanlog.RecordsChannel)
+			go func() {
+				defer GinkgoRecover()
+				for _, r := range eventRecords("a", "x", "b", "y", "c") {
+					ch <- r
+					time.Sleep(10 * time.Millisecond)
+				}
+			}()
+			Eventually(ch).Within(2 * time.Second).ProbeEvery(5 * time.Millisecond).
+				Should(ContainInOrder(events("a", "b", "c")...))
+		})
+
+		It("accepts plain record channels", func() {
+			ch := make(chan sdklog.Record, 10)
+			for _, r := range eventRecords("b", "a") {
+				ch <- r
+			}
+			Expect(ch).To(ConsistOfRecords(events("a", "b")...))
+			ch <- eventRecords("a")[0]
+			Expect((<-chan sdklog.Record)(ch)).To(ReceiveInOrder(events("a")...))
+		})
+
+		It("fails definitively on a non-matching record", func() {
+			ch := make(chanlog.RecordsChannel, 10)
+			for _, r := range eventRecords("a", "x") {
+				ch <- r
+			}
+			m := ReceiveInOrder(events("a", "b")...).(*RecordSequenceMatcher)
+			Expect(Successful(m.Match(ch))).To(BeFalse())
+			Expect(m.MatchMayChangeInTheFuture(ch)).To(BeFalse())
+			Expect(m.FailureMessage(ch)).To(ContainSubstring("step 2 of 2 failed for record #1"))
+		})
+
+		It("fails definitively on a closed channel", func() {
+			ch := make(chanlog.RecordsChannel, 10)
+			ch <- eventRecords("a")[0]
+			close(ch)
+			m := ContainInOrder(events("a", "b")...).(*RecordSequenceMatcher)
+			Expect(Successful(m.Match(ch))).To(BeFalse())
+			Expect(m.MatchMayChangeInTheFuture(ch)).To(BeFalse())
+			Expect(m.FailureMessage(ch)).To(ContainSubstring("channel closed before step 2 of 2"))
+		})
+
+		It("reports still waiting", func() {
+			ch := make(chanlog.RecordsChannel, 10)
+			m := ConsistOfRecords(events("a")...).(*RecordSequenceMatcher)
+			Expect(Successful(m.Match(ch))).To(BeFalse())
+			Expect(m.MatchMayChangeInTheFuture(ch)).To(BeTrue())
+			Expect(m.FailureMessage(ch)).To(ContainSubstring("still waiting for 1 remaining matchers"))
+			ch <- eventRecords("a")[0]
+			Expect(Successful(m.Match(ch))).To(BeTrue())
+		})
+
+	})
+
+})
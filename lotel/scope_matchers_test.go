@@ -0,0 +1,57 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package lotel
+
+import (
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/log/logtest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("instrumentation scope matchers", func() {
+
+	r := logtest.RecordFactory{
+		InstrumentationScope: &instrumentation.Scope{
+			Name:      "example.org/foo",
+			Version:   "v1.2.3",
+			SchemaURL: "https://opentelemetry.io/schemas/1.26.0",
+		},
+	}.NewRecord()
+
+	It("matches the scope name", func() {
+		Expect(r).To(HaveScopeName("example.org/foo"))
+		Expect(r).To(HaveScopeName(HavePrefix("example.org/")))
+		Expect(r).NotTo(HaveScopeName("example.org/bar"))
+	})
+
+	It("matches the scope version", func() {
+		Expect(r).To(HaveScopeVersion("v1.2.3"))
+		Expect(r).NotTo(HaveScopeVersion("v1.2.4"))
+	})
+
+	It("matches the scope schema URL", func() {
+		Expect(r).To(HaveScopeSchemaURL("https://opentelemetry.io/schemas/1.26.0"))
+		Expect(r).To(HaveScopeSchemaURL(HaveSuffix("/1.26.0")))
+		Expect(r).NotTo(HaveScopeSchemaURL(BeEmpty()))
+	})
+
+	It("matches the scope inside BeARecord", func() {
+		Expect(r).To(BeARecord(HaveScopeName("example.org/foo"), HaveScopeVersion("v1.2.3")))
+		Expect(r).NotTo(BeARecord(HaveScopeName("example.org/foo"), HaveScopeVersion("v1")))
+	})
+
+})
@@ -6,6 +6,7 @@ import (
 	"time"
 
 	"github.com/thediveo/otelcheck/lotel/testlogger"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/log"
 )
 
@@ -27,3 +28,42 @@ func Example() {
 	}
 	// Output: DO'H!
 }
+
+func ExampleNewProvider() {
+	// create a test logger provider that exports log records into a buffered
+	// channel with a capacity for 10 log records.
+	provider, shutdown, ch := testlogger.NewProvider(testlogger.WithCap(10))
+	defer shutdown(context.TODO())
+
+	r := log.Record{}
+	r.SetBody(log.StringValue("DO'H!"))
+	provider.Logger("example").Emit(context.TODO(), r)
+
+	select {
+	case r := <-ch:
+		fmt.Println(r.InstrumentationScope().Name, r.Body().AsString())
+	case <-time.After(5 * time.Second):
+		panic("expected to receive a log record")
+	}
+	// Output: example DO'H!
+}
+
+func ExampleWithResourceAttributes() {
+	// create a test logger with a deterministic resource, consisting only of
+	// the specified resource attributes.
+	logger, shutdown, ch := testlogger.New(10,
+		testlogger.WithResourceAttributes(
+			attribute.String("service.name", "foobar"),
+			attribute.String("service.version", "v1.2.3")))
+	defer shutdown(context.TODO())
+
+	logger.Emit(context.TODO(), log.Record{})
+
+	select {
+	case r := <-ch:
+		fmt.Println(r.Resource().String())
+	case <-time.After(5 * time.Second):
+		panic("expected to receive a log record")
+	}
+	// Output: service.name=foobar,service.version=v1.2.3
+}
@@ -42,20 +42,90 @@ const (
 // attribute that can be used in testing. This instrument attribute has the name
 // [InstrumentationAttributeName] and value [InstrumentationAttributeValue].
 //
+// The optional options allow to configure the logger's instrumentation scope,
+// the logger provider's resource, additional processors, as well as batching.
+// Please see [NewProvider] for details.
+//
 // # Notes
 //
 // In the OTel SDK, individual [log.Logger] objects cannot and don't need to be
 // shut down. However, the logger provider together with its processor(s) and
 // exporter(s) need to be shut down, using [sdklog.LoggerProvider.Shutdown]. We
 // don't expose the throw-away logger provider but instead expose an omnipotent
-// shutdown function.
-func New(capacity int) (log.Logger, func(context.Context), chanlog.RecordsChannel) {
-	exp, _ := chanlog.New(chanlog.WithCap(capacity))
-	proc := sdklog.NewSimpleProcessor(exp)
-	lp := sdklog.NewLoggerProvider(sdklog.WithProcessor(proc))
-	l := lp.Logger("testlogger",
+// shutdown function. Use [NewProvider] instead when the logger provider is
+// needed.
+func New(capacity int, opts ...Option) (log.Logger, func(context.Context), chanlog.RecordsChannel) {
+	o := newOptions(append([]Option{WithCap(capacity)}, opts...))
+	lp, shutdown, ch := newProvider(o)
+	l := lp.Logger(o.scopeName,
+		log.WithInstrumentationVersion(o.scopeVersion),
+		log.WithSchemaURL(o.schemaURL),
 		log.WithInstrumentationAttributes(
 			attribute.Int(InstrumentationAttributeName, InstrumentationAttributeValue)))
 
-	return l, func(ctx context.Context) { _ = lp.Shutdown(ctx) }, exp.Ch()
+	return l, shutdown, ch
+}
+
+// NewProvider returns a new OTel logger provider together with the log record
+// channel the provider's loggers export to, as well as a shutdown function.
+// Unless configured otherwise, NewProvider wires up the logger provider to an
+// exporter (using an [sdklog.SimpleProcessor]) that feeds into a Go chan
+// buffering log records.
+//
+// NewProvider can be configured using the following options:
+//   - [WithCap] configures the capacity of the log record channel, defaulting
+//     to 1.
+//   - [WithResource] and [WithResourceAttributes] configure the resource of the
+//     logger provider.
+//   - [WithProcessor] configures additional log record processors, which
+//     process log records before they get exported to the log record channel.
+//   - [WithBatching] uses an [sdklog.BatchProcessor] instead of an
+//     [sdklog.SimpleProcessor] to feed the log record channel.
+//
+// Callers should use the returned shutdown function to shut down the logger
+// provider as well as the exporter, with the channel also getting closed in the
+// process.
+//
+// Use NewProvider instead of [New] when the code under test needs a
+// [log.LoggerProvider], such as when testing logging bridges.
+func NewProvider(opts ...Option) (*sdklog.LoggerProvider, func(context.Context), chanlog.RecordsChannel) {
+	return newProvider(newOptions(opts))
+}
+
+// newOptions returns the options configured by the passed option functions,
+// including defaults.
+func newOptions(opts []Option) *options {
+	o := &options{
+		scopeName: "testlogger",
+	}
+	for _, opt := range opts {
+		opt(o)
+	}
+	return o
+}
+
+// newProvider returns a new logger provider together with its shutdown
+// function and log record channel, configured according to the passed options.
+func newProvider(o *options) (*sdklog.LoggerProvider, func(context.Context), chanlog.RecordsChannel) {
+	exp, _ := chanlog.New(chanlog.WithCap(o.capacity))
+	var proc sdklog.Processor
+	if o.batching {
+		proc = sdklog.NewBatchProcessor(exp, o.batchingOpts...)
+	} else {
+		proc = sdklog.NewSimpleProcessor(exp)
+	}
+	// the SDK calls processors in the order of their registration, so the
+	// additional processors need to come first in order for any changes they
+	// make to log records to reach the log record channel.
+	lpopts := make([]sdklog.LoggerProviderOption, 0, len(o.processors)+2)
+	for _, proc := range o.processors {
+		lpopts = append(lpopts, sdklog.WithProcessor(proc))
+	}
+	lpopts = append(lpopts, sdklog.WithProcessor(proc))
+	if o.resource != nil {
+		lpopts = append(lpopts, sdklog.WithResource(o.resource))
+	}
+	lp := sdklog.NewLoggerProvider(lpopts...)
+
+	return lp, func(ctx context.Context) { _ = lp.Shutdown(ctx) }, exp.Ch()
 }
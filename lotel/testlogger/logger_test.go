@@ -16,14 +16,30 @@ package testlogger
 
 import (
 	"context"
+	"time"
 
 	"github.com/thediveo/otelcheck/exporters/chanlog"
+	"go.opentelemetry.io/otel/attribute"
 	"go.opentelemetry.io/otel/log"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/sdk/resource"
 
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	. "github.com/thediveo/success"
 )
 
+// enrichingProcessor adds an attribute to each log record it processes.
+type enrichingProcessor struct{}
+
+func (enrichingProcessor) OnEmit(_ context.Context, r *sdklog.Record) error {
+	r.AddAttributes(log.String("enriched", "yes"))
+	return nil
+}
+
+func (enrichingProcessor) Shutdown(context.Context) error   { return nil }
+func (enrichingProcessor) ForceFlush(context.Context) error { return nil }
+
 var _ = Describe("OTel test logger", func() {
 
 	It("automatically shuts down the processor and exporter, closing the record channel", func(ctx context.Context) {
@@ -44,4 +60,91 @@ var _ = Describe("OTel test logger", func() {
 		l.Emit(ctx, r)
 	})
 
+	It("configures the logger's instrumentation scope", func(ctx context.Context) {
+		l, shutdown, ch := New(1,
+			WithScopeName("foo"),
+			WithScopeVersion("v1.2.3"),
+			WithSchemaURL("https://example.org/schema"))
+		defer shutdown(ctx)
+		l.Emit(ctx, log.Record{})
+		Eventually(ch).Should(Receive(HaveField("InstrumentationScope()", And(
+			HaveField("Name", "foo"),
+			HaveField("Version", "v1.2.3"),
+			HaveField("SchemaURL", "https://example.org/schema")))))
+	})
+
+	It("returns a provider with resource and additional processor", func(ctx context.Context) {
+		extraexp := Successful(chanlog.New(chanlog.WithCap(1)))
+		extrach := extraexp.Ch()
+		lp, shutdown, ch := NewProvider(
+			WithCap(2),
+			WithResource(resource.NewSchemaless(attribute.String("service.name", "foobar"))),
+			WithProcessor(sdklog.NewSimpleProcessor(extraexp)))
+		Expect(ch).To(HaveCap(2))
+		lp.Logger("foo").Emit(ctx, log.Record{})
+		Eventually(ch).Should(Receive(HaveField("Resource().String()", ContainSubstring("service.name=foobar"))))
+		Eventually(extrach).Should(Receive())
+
+		shutdown(ctx)
+		Expect(ch).To(BeClosed())
+		Expect(extrach).To(BeClosed())
+	})
+
+	It("exports log records after additional processors have processed them", func(ctx context.Context) {
+		l, shutdown, ch := New(1, WithProcessor(enrichingProcessor{}))
+		defer shutdown(ctx)
+		l.Emit(ctx, log.Record{})
+		var r sdklog.Record
+		Eventually(ch).Should(Receive(&r))
+		var attrs []log.KeyValue
+		r.WalkAttributes(func(kv log.KeyValue) bool {
+			attrs = append(attrs, kv)
+			return true
+		})
+		Expect(attrs).To(ContainElement(log.String("enriched", "yes")))
+	})
+
+	It("batches", func(ctx context.Context) {
+		l, shutdown, ch := New(10, WithBatching(sdklog.WithExportInterval(24*time.Hour)))
+		l.Emit(ctx, log.Record{})
+		l.Emit(ctx, log.Record{})
+		Consistently(ch).WithTimeout(100 * time.Millisecond).ShouldNot(Receive())
+		shutdown(ctx)
+		Expect(ch).To(HaveLen(2))
+	})
+
+	It("configures resource attributes", func(ctx context.Context) {
+		l, shutdown, ch := New(1,
+			WithResource(resource.NewSchemaless(attribute.String("service.name", "foo"))),
+			WithResourceAttributes(
+				attribute.String("service.name", "foobar"),
+				attribute.String("service.version", "v1.2.3")),
+			WithResourceAttributes(attribute.String("deployment.environment", "test")))
+		defer shutdown(ctx)
+		l.Emit(ctx, log.Record{})
+		var r sdklog.Record
+		Eventually(ch).Should(Receive(&r))
+		Expect(r.Resource().Attributes()).To(ConsistOf(
+			attribute.String("service.name", "foobar"),
+			attribute.String("service.version", "v1.2.3"),
+			attribute.String("deployment.environment", "test")))
+	})
+
+	It("merges resource attributes with a later resource", func(ctx context.Context) {
+		l, shutdown, ch := New(1,
+			WithResourceAttributes(
+				attribute.String("service.name", "foo"),
+				attribute.String("service.version", "v1.2.3")),
+			WithResource(resource.NewSchemaless(attribute.String("service.name", "foobar"))),
+			WithResource(resource.NewSchemaless(attribute.String("deployment.environment", "test"))))
+		defer shutdown(ctx)
+		l.Emit(ctx, log.Record{})
+		var r sdklog.Record
+		Eventually(ch).Should(Receive(&r))
+		Expect(r.Resource().Attributes()).To(ConsistOf(
+			attribute.String("service.name", "foobar"),
+			attribute.String("service.version", "v1.2.3"),
+			attribute.String("deployment.environment", "test")))
+	})
+
 })
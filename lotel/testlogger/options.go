@@ -0,0 +1,144 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package testlogger
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+	sdklog "go.opentelemetry.io/otel/sdk/log"
+	"go.opentelemetry.io/otel/sdk/resource"
+)
+
+// Option configures a test logger provider created by [NewProvider], or a test
+// logger created by [New].
+type Option func(*options)
+
+type options struct {
+	capacity     int
+	scopeName    string
+	scopeVersion string
+	schemaURL    string
+	resource     *resource.Resource
+	processors   []sdklog.Processor
+	batching     bool
+	batchingOpts []sdklog.BatchProcessorOption
+}
+
+// WithCap configures the capacity of the log record channel. The specified
+// capacity is clamped to at least 1.
+func WithCap(capacity int) func(o *options) {
+	return func(o *options) {
+		o.capacity = max(capacity, 1)
+	}
+}
+
+// WithScopeName configures the instrumentation scope name of the logger
+// returned by [New], overriding the default scope name “testlogger”. It does
+// not affect [NewProvider].
+func WithScopeName(name string) func(o *options) {
+	return func(o *options) {
+		o.scopeName = name
+	}
+}
+
+// WithScopeVersion configures the instrumentation scope version of the logger
+// returned by [New]. It does not affect [NewProvider].
+func WithScopeVersion(version string) func(o *options) {
+	return func(o *options) {
+		o.scopeVersion = version
+	}
+}
+
+// WithSchemaURL configures the instrumentation scope schema URL of the logger
+// returned by [New]. It does not affect [NewProvider].
+func WithSchemaURL(schemaURL string) func(o *options) {
+	return func(o *options) {
+		o.schemaURL = schemaURL
+	}
+}
+
+// WithResource configures the resource of the logger provider. The passed
+// resource gets merged with the resource attributes from the environment, as
+// is usual with [sdklog.WithResource]. If not configured, the logger provider
+// uses the default resource.
+//
+// When used multiple times or together with [WithResourceAttributes], in any
+// order, the resources get merged, with the later attribute values taking
+// precedence. When merging resources with different schema URLs, the merged
+// resource has no schema URL.
+//
+// See also [WithResourceAttributes].
+func WithResource(res *resource.Resource) func(o *options) {
+	return func(o *options) {
+		if o.resource != nil {
+			// a schema URL conflict still returns a usable, schemaless
+			// merged resource.
+			res, _ = resource.Merge(o.resource, res)
+		}
+		o.resource = res
+	}
+}
+
+// WithResourceAttributes configures the resource of the logger provider to
+// consist of the specified resource attributes, such as “service.name”,
+// “service.version”, and “deployment.environment”. This allows for
+// deterministic resource attribute assertions, as the resource will then not
+// contain any default attributes, such as “telemetry.sdk.name”, et cetera.
+//
+// When used multiple times or together with [WithResource], in any order, the
+// resource attributes get merged, with the later attribute values taking
+// precedence.
+//
+// Please note that resource attributes from the OTEL_RESOURCE_ATTRIBUTES
+// environment variable will still be merged, as is usual with
+// [sdklog.WithResource].
+func WithResourceAttributes(attrs ...attribute.KeyValue) func(o *options) {
+	return func(o *options) {
+		res := resource.NewSchemaless(attrs...)
+		if o.resource != nil {
+			// merging schemaless resources never fails.
+			res, _ = resource.Merge(o.resource, res)
+		}
+		o.resource = res
+	}
+}
+
+// WithProcessor configures an additional log record processor, in addition to
+// the processor feeding the log record channel. WithProcessor can be used
+// multiple times to configure multiple additional processors.
+//
+// The additional processors get registered in the order of the WithProcessor
+// options and always before the processor feeding the log record channel. As
+// the OTel SDK calls processors in the order of their registration, any
+// changes to log records made by additional processors, such as enriching or
+// redacting attributes, thus show in the log records received from the
+// channel.
+func WithProcessor(processor sdklog.Processor) func(o *options) {
+	return func(o *options) {
+		o.processors = append(o.processors, processor)
+	}
+}
+
+// WithBatching configures an [sdklog.BatchProcessor] with the specified
+// options to feed the log record channel, instead of the default
+// [sdklog.SimpleProcessor]. Please note that with batching log records arrive
+// in the channel only after either the batch export interval has expired, the
+// batch size has been reached, or the logger provider has been flushed or
+// shut down.
+func WithBatching(opts ...sdklog.BatchProcessorOption) func(o *options) {
+	return func(o *options) {
+		o.batching = true
+		o.batchingOpts = opts
+	}
+}
@@ -0,0 +1,91 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package lotel
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/sdk/log/logtest"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("trace correlation matchers", func() {
+
+	sc := trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID:    trace.TraceID{0x01, 0x02},
+		SpanID:     trace.SpanID{0x03, 0x04},
+		TraceFlags: trace.FlagsSampled,
+	})
+
+	It("matches trace ID, span ID, and trace flags", func() {
+		r := logtest.RecordFactory{
+			TraceID:    sc.TraceID(),
+			SpanID:     sc.SpanID(),
+			TraceFlags: sc.TraceFlags(),
+		}.NewRecord()
+		Expect(r).To(HaveTraceID(sc.TraceID()))
+		Expect(r).To(HaveSpanID(sc.SpanID()))
+		Expect(r).To(HaveTraceFlags(trace.FlagsSampled))
+		Expect(r).To(HaveTraceFlags(WithTransform(trace.TraceFlags.IsSampled, BeTrue())))
+		Expect(r).To(BeARecord(HaveTraceID(Not(BeZero())), HaveSpanID(Not(BeZero()))))
+
+		r = logtest.RecordFactory{}.NewRecord()
+		Expect(r).NotTo(HaveTraceID(sc.TraceID()))
+		Expect(r).NotTo(HaveSpanID(sc.SpanID()))
+		Expect(r).NotTo(HaveTraceFlags(trace.FlagsSampled))
+	})
+
+	It("matches records in spans", func(ctx context.Context) {
+		r := logtest.RecordFactory{
+			TraceID: sc.TraceID(),
+			SpanID:  sc.SpanID(),
+		}.NewRecord()
+		Expect(r).To(BeInSpan(sc))
+		Expect(r).To(BeInSpan(trace.ContextWithSpanContext(ctx, sc)))
+		Expect(r).To(BeARecord(BeInSpan(trace.SpanFromContext(trace.ContextWithSpanContext(ctx, sc)))))
+
+		other := trace.NewSpanContext(trace.SpanContextConfig{
+			TraceID: sc.TraceID(),
+			SpanID:  trace.SpanID{0x05, 0x06},
+		})
+		Expect(r).NotTo(BeInSpan(other))
+	})
+
+	It("matches records emitted in an SDK span", func(ctx context.Context) {
+		tp := sdktrace.NewTracerProvider()
+		defer func() { _ = tp.Shutdown(ctx) }()
+		ctx, span := tp.Tracer("test").Start(ctx, "foo")
+		defer span.End()
+
+		sc := span.SpanContext()
+		r := logtest.RecordFactory{
+			TraceID: sc.TraceID(),
+			SpanID:  sc.SpanID(),
+		}.NewRecord()
+		Expect(r).To(BeInSpan(ctx))
+		Expect(r).To(BeInSpan(span))
+	})
+
+	It("rejects invalid spans", func(ctx context.Context) {
+		r := logtest.RecordFactory{}.NewRecord()
+		Expect(BeInSpan(ctx).Match(r)).Error().To(MatchError(ContainSubstring("valid span context")))
+		Expect(BeInSpan(42).Match(r)).Error().To(MatchError(ContainSubstring("BeInSpan expected")))
+	})
+
+})
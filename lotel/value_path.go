@@ -0,0 +1,143 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package lotel
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/thediveo/otelcheck/lotel/logconv"
+
+	"github.com/onsi/gomega/format"
+	ty "github.com/onsi/gomega/types"
+)
+
+// parseValuePath parses a path into structured values, returning the path's
+// segments. The path is either in JSON pointer syntax (RFC 6901) when starting
+// with a “/”, or otherwise in dotted syntax, such as “foo.bar.0”. An empty path
+// refers to the value itself.
+func parseValuePath(path string) ([]string, error) {
+	if path == "" {
+		return nil, nil
+	}
+	if strings.HasPrefix(path, "/") {
+		segments := strings.Split(path[1:], "/")
+		for idx, segment := range segments {
+			for i := 0; i < len(segment); i++ {
+				if segment[i] == '~' &&
+					(i+1 == len(segment) || (segment[i+1] != '0' && segment[i+1] != '1')) {
+					return nil, fmt.Errorf("invalid JSON pointer escape in path segment %q", segment)
+				}
+			}
+			segments[idx] = strings.ReplaceAll(strings.ReplaceAll(segment, "~1", "/"), "~0", "~")
+		}
+		return segments, nil
+	}
+	segments := strings.Split(path, ".")
+	for _, segment := range segments {
+		if segment == "" {
+			return nil, fmt.Errorf("empty segment in dotted path %q", path)
+		}
+	}
+	return segments, nil
+}
+
+// valueAt descends along the passed path segments into the passed any-fied
+// value (see [logconv.Any]), returning the value found and true, or false if
+// there is no value at the specified path.
+func valueAt(value any, segments []string) (any, bool) {
+	for _, segment := range segments {
+		switch v := value.(type) {
+		case map[string]any:
+			el, ok := v[segment]
+			if !ok {
+				return nil, false
+			}
+			value = el
+		case []any:
+			idx, err := strconv.Atoi(segment)
+			if err != nil || idx < 0 || idx >= len(v) {
+				return nil, false
+			}
+			value = v[idx]
+		default:
+			return nil, false
+		}
+	}
+	return value, true
+}
+
+// valueAtMatcher matches an any-fied log value (see [logconv.Any]) by
+// descending along a path into structured map and slice values and then
+// matching the value found against the expected value.
+type valueAtMatcher struct {
+	path     string
+	segments []string
+	err      error // path parsing or expected value conversion error, if any
+	expected any
+	matcher  ty.GomegaMatcher
+}
+
+var _ ty.GomegaMatcher = (*valueAtMatcher)(nil)
+
+// haveValueAt returns a matcher that succeeds if an any-fied log value has a
+// value matching the expected value at the specified path. Plain expected
+// values are canonized before comparing them using [g.Equal], whereas
+// [ty.GomegaMatcher] expectations are used as is.
+func haveValueAt(path string, expected any) *valueAtMatcher {
+	segments, err := parseValuePath(path)
+	matcher, convErr := matcherOrEqualNilInclusive(expected, logconv.TryCanonize)
+	if err == nil {
+		err = convErr
+	}
+	return &valueAtMatcher{
+		path:     path,
+		segments: segments,
+		err:      err,
+		expected: expected,
+		matcher:  matcher,
+	}
+}
+
+func (m *valueAtMatcher) Match(actual any) (success bool, err error) {
+	if m.err != nil {
+		return false, m.err
+	}
+	if m.matcher == nil {
+		return false, errors.New("refusing to match against <nil> matcher")
+	}
+	value, ok := valueAt(actual, m.segments)
+	if !ok {
+		return false, nil
+	}
+	return m.matcher.Match(value)
+}
+
+func (m *valueAtMatcher) expectation() string {
+	return fmt.Sprintf("path:\n%s\nvalue:\n%s",
+		format.Object(m.path, 1), format.Object(m.expected, 1))
+}
+
+func (m *valueAtMatcher) FailureMessage(actual any) (message string) {
+	return fmt.Sprintf("Expected\n%s\nto have at\n%s",
+		format.Object(actual, 1), format.IndentString(m.expectation(), 1))
+}
+
+func (m *valueAtMatcher) NegatedFailureMessage(actual any) (message string) {
+	return fmt.Sprintf("Expected\n%s\nnot to have at\n%s",
+		format.Object(actual, 1), format.IndentString(m.expectation(), 1))
+}
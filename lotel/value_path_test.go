@@ -0,0 +1,122 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package lotel
+
+import (
+	"go.opentelemetry.io/otel/log"
+	"go.opentelemetry.io/otel/sdk/log/logtest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	ty "github.com/onsi/gomega/types"
+	. "github.com/thediveo/otelcheck/x/iff"
+)
+
+var _ = Describe("path-based matching", func() {
+
+	DescribeTable("parsing paths",
+		func(path string, expected []string) {
+			Expect(parseValuePath(path)).To(Equal(expected))
+		},
+		Entry(nil, "", nil),
+		Entry(nil, "foo", []string{"foo"}),
+		Entry(nil, "foo.bar.0", []string{"foo", "bar", "0"}),
+		Entry(nil, "/", []string{""}),
+		Entry(nil, "/foo/bar", []string{"foo", "bar"}),
+		Entry(nil, "/foo.bar/a~1b/c~0d", []string{"foo.bar", "a/b", "c~d"}),
+	)
+
+	DescribeTable("rejecting invalid paths",
+		func(path string) {
+			Expect(parseValuePath(path)).Error().To(HaveOccurred())
+		},
+		Entry(nil, "foo..bar"),
+		Entry(nil, "."),
+		Entry(nil, "/foo~"),
+		Entry(nil, "/foo~2"),
+	)
+
+	r := logtest.RecordFactory{
+		Body: log.MapValue(
+			log.Map("user",
+				log.String("name", "foo"),
+				log.Slice("roles", log.StringValue("admin"), log.StringValue("user"))),
+			log.Int("answer", 42),
+			log.Float64("pi", 3.14),
+			log.Empty("nothing"),
+			log.String("dotted.key", "bar")),
+		Attributes: []log.KeyValue{
+			log.Map("http.request",
+				log.Map("headers", log.String("accept", "text/plain"))),
+		},
+	}.NewRecord()
+
+	DescribeTable("matching body fields",
+		func(path string, expected any, match bool) {
+			If(match, Assertion.To, Assertion.NotTo)(Expect(r), HaveBodyField(path, expected))
+		},
+		Entry(nil, "user.name", "foo", true),
+		Entry(nil, "/user/name", "foo", true),
+		Entry(nil, "user.name", "bar", false),
+		Entry(nil, "user.roles.1", "user", true),
+		Entry(nil, "user.roles", ContainElement("admin"), true),
+		Entry(nil, "user.roles", []any{"admin", "user"}, true),
+		Entry(nil, "user.roles.2", "user", false),
+		Entry(nil, "user.roles.foo", "user", false),
+		Entry(nil, "user.name.foo", "foo", false),
+		Entry(nil, "answer", 42, true),
+		Entry(nil, "answer", BeNumerically(">", 40), true),
+		Entry(nil, "pi", 3.14, true),
+		Entry(nil, "nothing", nil, true),
+		Entry(nil, "missing", nil, false),
+		Entry(nil, "/dotted.key", "bar", true),
+		Entry(nil, "", HaveKey("answer"), true),
+	)
+
+	It("reports invalid paths", func() {
+		Expect(HaveBodyField("foo..bar", 42).Match(r)).Error().To(
+			MatchError(ContainSubstring("empty segment in dotted path")))
+		Expect(HaveAttributeAt("http.request", "/~", 42).Match(r)).Error().To(
+			MatchError(ContainSubstring("invalid JSON pointer escape")))
+	})
+
+	It("reports invalid paths and values even without matching attribute key", func() {
+		Expect(BeARecord(HaveAttributeAt("nope", "a..b", 1)).Match(r)).Error().To(
+			MatchError(ContainSubstring("empty segment in dotted path")))
+		Expect(HaveAttributeAt("nope", "a.b", make(chan int)).Match(r)).Error().To(
+			MatchError(ContainSubstring("unsupported type chan int")))
+		Expect(BeARecord(HaveAttributeAt("nope", "a.b", 1)).Match(r)).To(BeFalse())
+	})
+
+	DescribeTable("matching attribute values",
+		func(m ty.GomegaMatcher, match bool) {
+			If(match, Assertion.To, Assertion.NotTo)(Expect(r), BeARecord(m))
+		},
+		Entry(nil, HaveAttributeAt("http.request", "headers.accept", "text/plain"), true),
+		Entry(nil, HaveAttributeAt("http.request", "/headers/accept", HavePrefix("text/")), true),
+		Entry(nil, HaveAttributeAt("http.request", "headers.accept", "text/html"), false),
+		Entry(nil, HaveAttributeAt("http.response", "headers.accept", "text/plain"), false),
+	)
+
+	It("has helpful failure messages", func() {
+		Expect(HaveBodyField("user.name", "bar").FailureMessage(r)).To(
+			MatchRegexp(`to have body field\n\s+<string>: user.name\nmatching\n\s+<string>: bar`))
+		m := haveValueAt("foo", 42)
+		Expect(m.FailureMessage(map[string]any{})).To(
+			MatchRegexp(`to have at\n\s+path:\n\s+<string>: foo\n\s+value:\n\s+<int>: 42`))
+		Expect(m.NegatedFailureMessage(map[string]any{})).To(ContainSubstring("not to have at"))
+	})
+
+})
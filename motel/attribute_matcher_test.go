@@ -0,0 +1,92 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package motel
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	ty "github.com/onsi/gomega/types"
+	. "github.com/thediveo/otelcheck/x/iff"
+)
+
+var _ = Describe("HaveAttribute(WithValue) matchers", func() {
+
+	DescribeTable("matches attributes using name[=value] format",
+		func(attrspec string, attr attribute.KeyValue, match bool) {
+			If(match, Assertion.To, Assertion.NotTo)(Expect(attr),
+				HaveAttribute(attrspec))
+		},
+		Entry(nil, "foo", attribute.String("foo", "bar"), true),
+		Entry(nil, "foo=bar", attribute.String("foo", "bar"), true),
+		Entry(nil, "foo=foo", attribute.String("foo", "bar"), false),
+		Entry(nil, "foo=", attribute.String("foo", ""), true),
+		Entry(nil, "bar", attribute.String("foo", "bar"), false),
+	)
+
+	DescribeTable("matches attributes using explicit name, value",
+		func(name, value any, attr attribute.KeyValue, match bool) {
+			If(match, Assertion.To, Assertion.NotTo)(Expect(attr),
+				HaveAttributeWithValue(name, value))
+		},
+		Entry(nil, "foo", "bar", attribute.String("foo", "bar"), true),
+		Entry(nil, "foo", 42, attribute.Int("foo", 42), true),
+		Entry(nil, "foo", 42, attribute.Float64("foo", 42), false),
+		Entry(nil, "foo", []int{1, 2}, attribute.IntSlice("foo", []int{1, 2}), true),
+		Entry(nil, "foo", BeNumerically(">", 0), attribute.Int("foo", 42), true),
+	)
+
+	DescribeTable("matches attribute sets and data points",
+		func(actual any, m ty.GomegaMatcher, match bool) {
+			If(match, Assertion.To, Assertion.NotTo)(Expect(actual), m)
+		},
+		Entry(nil, attribute.NewSet(attribute.String("foo", "bar")), HaveAttribute("foo=bar"), true),
+		Entry(nil, attribute.NewSet(attribute.String("foo", "bar")), HaveAttribute("baz"), false),
+		Entry(nil, metricdata.DataPoint[int64]{
+			Attributes: attribute.NewSet(attribute.String("foo", "bar")),
+		}, HaveAttribute("foo"), true),
+		Entry(nil, metricdata.HistogramDataPoint[float64]{
+			Attributes: attribute.NewSet(attribute.String("foo", "bar")),
+		}, HaveAttribute("foo"), true),
+		Entry(nil, metricdata.Metrics{
+			Data: metricdata.Sum[int64]{DataPoints: []metricdata.DataPoint[int64]{
+				{Attributes: attribute.NewSet(attribute.Int("baz", 1))},
+				{Attributes: attribute.NewSet(attribute.String("foo", "bar"))},
+			}},
+		}, HaveAttribute("foo=bar"), true),
+	)
+
+	It("returns errors", func() {
+		Expect(HaveAttribute("foo").Match(nil)).Error().To(HaveOccurred())
+		Expect(HaveAttribute("foo").Match(42)).Error().To(
+			MatchError(ContainSubstring("HaveAttribute expected actual of type")))
+		Expect(HaveAttribute(BeTrue()).Match(attribute.String("foo", "bar"))).Error().To(HaveOccurred())
+		Expect((&HaveAttributeMatcher{}).Match(attribute.String("foo", "bar"))).Error().To(
+			MatchError(ContainSubstring("name matcher must not be <nil>")))
+		Expect(HaveAttributeWithValue("foo", make(chan int)).Match(attribute.String("foo", "bar"))).Error().To(
+			MatchError(ContainSubstring("cannot convert expected value")))
+	})
+
+	It("returns failure messages", func() {
+		m := HaveAttributeWithValue("foo", 42)
+		Expect(m.FailureMessage(attribute.String("foo", "bar"))).To(
+			MatchRegexp(`(?s)to have attribute\s+key:.*foo\s+value:.*42`))
+		Expect(m.NegatedFailureMessage(attribute.String("foo", "bar"))).To(
+			ContainSubstring("not to have attribute"))
+	})
+
+})
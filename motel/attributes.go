@@ -1,11 +1,30 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
 package motel
 
-/*
 import (
-	"fmt"
+	"iter"
+	"slices"
 
-	"github.com/onsi/gomega/types"
 	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	"github.com/thediveo/otelcheck/lotel/logconv"
+	intslices "github.com/thediveo/otelcheck/x/slices"
+
+	ty "github.com/onsi/gomega/types"
 )
 
 // attributes in OTel are key-value pairs, where the keys always are strings,
@@ -15,61 +34,145 @@ import (
 //  - float64 and []float64
 //  - string and []string
 
-// HaveAttribute succeeds if a metric has an attribute with the specified name
-// (key) and optional value.
-//
-// The value passed into the attribute parameter can be either a string or
-// [types.GomegaMatcher]:
-//   - a string in the form of “name” where it must match an attribute key, or
-//     in the “name=value” form where it must match both the attribute key and
-//     string value.
-//   - a GomegaMatcher that matches the (string) key only.
-//   - any other type of value is an error.
-//
-// To test for attribute values other than string, use [HaveAttributeWithValue]
-// instead.
-func HaveAttribute(attribute any) {}
-
-// HaveAttributeWithValue succeeds if a metric has an attibute with the
-// specified name (key) and value.
-func HaveAttributeWithValue(name any, value any) {}
-
+// attributeMatcher marks a Gomega matcher to match OTel metric data point
+// attributes, as well as resource and scope attributes.
 type attributeMatcher interface {
-	matchAttribute(attribute.Set) (bool, error)
+	// try to match an attribute by its name and any-fied/canonized value.
+	matchAttribute(name string, value any) (bool, error)
 }
 
-type HaveAttributeMatcher struct {
-	name         any
-	value        any
-	nameMatcher  types.GomegaMatcher
-	valueMatcher types.GomegaMatcher
+// containsAttributes succeeds if all passed attribute matchers match on (some
+// of) the passed resource and scope attributes, as well as on the attributes
+// of at least one of the metric's data points. The resource and scope
+// attribute sets might be nil.
+func containsAttributes(res, scope *attribute.Set, m *metricdata.Metrics, attrms []attributeMatcher) (bool, error) {
+	attrms = slices.Clone(attrms)
+	var err error
+	for _, attrs := range []*attribute.Set{res, scope} {
+		if attrs == nil {
+			continue
+		}
+		attrms, err = removeMatchingMatchers(attrs, attrms)
+		if err != nil {
+			return false, err
+		}
+		if len(attrms) == 0 {
+			return true, nil
+		}
+	}
+	// all remaining attribute matchers must now be satisfied by the attributes
+	// of a single data point.
+	for attrs := range dataPoints(m.Data) {
+		leftover, err := removeMatchingMatchers(attrs, slices.Clone(attrms))
+		if err != nil {
+			return false, err
+		}
+		if len(leftover) == 0 {
+			return true, nil
+		}
+	}
+	return false, nil
 }
 
-var (
-	_ attributeMatcher = (*HaveAttributeMatcher)(nil)
-)
-
-func (m *HaveAttributeMatcher) matchAttribute(set attribute.Set) (bool, error) {
-	if m.nameMatcher == nil {
-		return false, fmt.Errorf("name matcher must not be <nil>")
+// removeMatchingMatchers checks which attribute matchers match on the
+// passed attribute set and then returns only the "left-over" non-matching
+// matchers.
+func removeMatchingMatchers(attrs *attribute.Set, attrms []attributeMatcher) ([]attributeMatcher, error) {
+	it := attrs.Iter()
+nextAttribute:
+	for it.Next() {
+		if len(attrms) == 0 {
+			return attrms, nil
+		}
+		attr := it.Attribute()
+		value := logconv.Any(logconv.FromAttribute(attr.Value))
+		for midx, m := range attrms {
+			success, err := m.matchAttribute(string(attr.Key), value)
+			if err != nil {
+				return nil, err
+			}
+			if success {
+				attrms = intslices.DeleteUnordered(attrms, midx)
+				continue nextAttribute
+			}
+		}
 	}
-	// ………
+	return attrms, nil
+}
 
+// separateAttributeMatchers separates a list of matchers into a list of
+// attribute matchers as well as the list of non-attribute matchers.
+func separateAttributeMatchers(ms []ty.GomegaMatcher) ([]ty.GomegaMatcher, []attributeMatcher) {
+	gms := make([]ty.GomegaMatcher, 0, len(ms))
+	var ams []attributeMatcher
+	for _, m := range ms {
+		if am, ok := m.(attributeMatcher); ok {
+			ams = append(ams, am)
+			continue
+		}
+		gms = append(gms, m)
+	}
+	return gms, ams
 }
 
-// matchAllAttributes succeeds if all exepected attributes match (a subset of)
-// the actual labels. It returns an error as soon as any underlying attribute
-// matcher returns an error.
-func matchAllAttributes(actual attribute.Set, expected []attributeMatcher) (bool, error) {
-	for _, matcher := range expected {
-		success, err := matcher.matchAttribute(actual)
-		if err != nil {
-			return false, err
+// dataPoints returns an iterator over the data points of the passed metric
+// aggregation data, yielding the attribute set as well as the data point itself
+// (such as [metricdata.DataPoint] or [metricdata.HistogramDataPoint]).
+func dataPoints(data metricdata.Aggregation) iter.Seq2[*attribute.Set, any] {
+	return func(yield func(*attribute.Set, any) bool) {
+		switch data := data.(type) {
+		case metricdata.Gauge[int64]:
+			walkDataPoints(data.DataPoints, yield)
+		case metricdata.Gauge[float64]:
+			walkDataPoints(data.DataPoints, yield)
+		case metricdata.Sum[int64]:
+			walkDataPoints(data.DataPoints, yield)
+		case metricdata.Sum[float64]:
+			walkDataPoints(data.DataPoints, yield)
+		case metricdata.Histogram[int64]:
+			walkDataPoints(data.DataPoints, yield)
+		case metricdata.Histogram[float64]:
+			walkDataPoints(data.DataPoints, yield)
+		case metricdata.ExponentialHistogram[int64]:
+			walkDataPoints(data.DataPoints, yield)
+		case metricdata.ExponentialHistogram[float64]:
+			walkDataPoints(data.DataPoints, yield)
+		case metricdata.Summary:
+			walkDataPoints(data.DataPoints, yield)
 		}
-		if !success {
-			return false, nil
+	}
+}
+
+// walkDataPoints yields the attribute sets and data points of the passed data
+// points slice, until either all data points have been yielded or yield
+// returns false.
+func walkDataPoints[DP any](dps []DP, yield func(*attribute.Set, any) bool) {
+	for _, dp := range dps {
+		attrs, _ := dataPointAttributes(dp)
+		if !yield(attrs, dp) {
+			return
 		}
 	}
-	return true, nil
 }
-*/
+
+// dataPointAttributes returns the attribute set of the passed data point, and
+// false if the passed value isn't a data point.
+func dataPointAttributes(dp any) (*attribute.Set, bool) {
+	switch dp := dp.(type) {
+	case metricdata.DataPoint[int64]:
+		return &dp.Attributes, true
+	case metricdata.DataPoint[float64]:
+		return &dp.Attributes, true
+	case metricdata.HistogramDataPoint[int64]:
+		return &dp.Attributes, true
+	case metricdata.HistogramDataPoint[float64]:
+		return &dp.Attributes, true
+	case metricdata.ExponentialHistogramDataPoint[int64]:
+		return &dp.Attributes, true
+	case metricdata.ExponentialHistogramDataPoint[float64]:
+		return &dp.Attributes, true
+	case metricdata.SummaryDataPoint:
+		return &dp.Attributes, true
+	}
+	return nil, false
+}
@@ -0,0 +1,60 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package motel
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	gc "github.com/onsi/gomega/gcustom"
+	ty "github.com/onsi/gomega/types"
+)
+
+// BeAMetric succeeds if the actual metric satisfies all specified matchers. It
+// is an error for actual not to be of type [metricdata.Metrics].
+//
+// All attribute matchers, such as [HaveAttribute] and
+// [HaveAttributeWithValue], must be satisfied by the attributes of the same
+// data point of the actual metric.
+func BeAMetric(m ty.GomegaMatcher, ms ...ty.GomegaMatcher) ty.GomegaMatcher {
+	ms = append([]ty.GomegaMatcher{m}, ms...)
+	gms, ams := separateAttributeMatchers(ms)
+	return gc.MakeMatcher(func(m metricdata.Metrics) (bool, error) {
+		return matchMetric(nil, nil, &m, gms, ams)
+	}).WithTemplate("Expected:\n{{.FormattedActual}}\n{{.To}} match\n{{format .Data 1}}").
+		WithTemplateData(ms)
+}
+
+// matchMetric succeeds if the passed metric satisfies all passed
+// (non-attribute) matchers as well as all attribute matchers. The attribute
+// matchers additionally match against the passed resource and scope attribute
+// sets, unless nil.
+func matchMetric(
+	res, scope *attribute.Set,
+	m *metricdata.Metrics,
+	gms []ty.GomegaMatcher,
+	ams []attributeMatcher,
+) (bool, error) {
+	for _, gm := range gms {
+		success, err := gm.Match(*m)
+		if err != nil || !success {
+			return false, err
+		}
+	}
+	if len(ams) == 0 {
+		return true, nil
+	}
+	return containsAttributes(res, scope, m, ams)
+}
@@ -0,0 +1,81 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package motel
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var counter = metricdata.Metrics{
+	Name:        "foo.requests",
+	Description: "number of foo requests",
+	Unit:        "{request}",
+	Data: metricdata.Sum[int64]{
+		DataPoints: []metricdata.DataPoint[int64]{
+			{
+				Attributes: attribute.NewSet(
+					attribute.String("method", "GET"),
+					attribute.Int("status", 200)),
+				Value: 42,
+			},
+			{
+				Attributes: attribute.NewSet(
+					attribute.String("method", "POST"),
+					attribute.Int("status", 404)),
+				Value: 1,
+			},
+		},
+		Temporality: metricdata.CumulativeTemporality,
+		IsMonotonic: true,
+	},
+}
+
+var _ = Describe("BeAMetric matcher", func() {
+
+	It("fails when not given a metric", func() {
+		Expect(BeAMetric(HaveName("foo")).Match(42)).Error().To(HaveOccurred())
+	})
+
+	It("matches a metric", func() {
+		Expect(counter).To(BeAMetric(
+			HaveName("foo.requests"),
+			HaveUnit("{request}"),
+			HaveDescription(ContainSubstring("foo"))))
+		Expect(counter).NotTo(BeAMetric(
+			HaveName("foo.requests"),
+			HaveUnit("By")))
+	})
+
+	It("matches all attributes on the same data point", func() {
+		Expect(counter).To(BeAMetric(
+			HaveAttribute("method=GET"),
+			HaveAttributeWithValue("status", 200)))
+		Expect(counter).To(BeAMetric(
+			HaveAttribute("method=POST"),
+			HaveAttributeWithValue("status", 404)))
+		Expect(counter).NotTo(BeAMetric(
+			HaveAttribute("method=GET"),
+			HaveAttributeWithValue("status", 404)))
+	})
+
+	It("returns attribute matching errors", func() {
+		Expect(BeAMetric(HaveAttribute(BeTrue())).Match(counter)).Error().To(HaveOccurred())
+	})
+
+})
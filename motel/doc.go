@@ -0,0 +1,15 @@
+/*
+Package motel provides Gomega matchers for reasoning about OpenTelemetry
+metrics.
+
+The matchers in this package hide the nesting of the OTel SDK metric data model
+of [metricdata.ResourceMetrics], [metricdata.ScopeMetrics],
+[metricdata.Metrics], and finally the individual data points inside a metric's
+aggregation data. For instance:
+
+	Expect(rm).To(HaveMetric(
+		HaveName("foo.requests"),
+		HaveUnit("{request}"),
+		HaveAttributeWithValue("http.method", "GET")))
+*/
+package motel
@@ -0,0 +1,63 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package motel
+
+import (
+	g "github.com/onsi/gomega"
+	ty "github.com/onsi/gomega/types"
+)
+
+// matcherOrEqual either returns any passed-in [ty.GomegaMatcher] value as-is
+// and otherwise wraps all other expected values into a [g.Equal] matcher.
+func matcherOrEqual(expected any) ty.GomegaMatcher {
+	if m, ok := expected.(ty.GomegaMatcher); ok {
+		return m
+	}
+	return g.Equal(expected)
+}
+
+// matcherOrEqualNilInclusive either returns any passed-in [ty.GomegaMatcher]
+// value as-is and otherwise wraps all other expected values into either a
+// [g.Equal] or [g.BeNil] matcher, depending on expected.
+//
+// When passed a conversion function, matcherOrEqualNilInclusive converts a
+// non-nil expected value before wrapping it, returning any conversion error.
+func matcherOrEqualNilInclusive(expected any, fn ...func(any) (any, error)) (ty.GomegaMatcher, error) {
+	if m, ok := expected.(ty.GomegaMatcher); ok {
+		return m, nil
+	}
+	if expected == nil {
+		return g.BeNil(), nil
+	}
+	if len(fn) > 0 {
+		expected, err := fn[0](expected)
+		if err != nil {
+			return nil, err
+		}
+		return g.Equal(expected), nil
+	}
+	return g.Equal(expected), nil
+}
+
+// matcherOrNumericallyEqual either returns any passed-in [ty.GomegaMatcher]
+// value as-is and otherwise wraps all other expected values into a
+// [g.BeNumerically] “==” matcher, so that expected values of any numeric type
+// match data point values of either int64 or float64 type.
+func matcherOrNumericallyEqual(expected any) ty.GomegaMatcher {
+	if m, ok := expected.(ty.GomegaMatcher); ok {
+		return m
+	}
+	return g.BeNumerically("==", expected)
+}
@@ -0,0 +1,66 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package motel
+
+import (
+	"slices"
+
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	gc "github.com/onsi/gomega/gcustom"
+	ty "github.com/onsi/gomega/types"
+)
+
+// HaveDataPoint succeeds if the actual metric has at least one data point
+// satisfying all specified matchers. It is an error for actual not to be of
+// type [metricdata.Metrics].
+//
+// The matchers get passed the individual data points, such as
+// [metricdata.DataPoint], [metricdata.HistogramDataPoint], et cetera, depending
+// on the aggregation of the actual metric. Attribute matchers, such as
+// [HaveAttribute], match only the data point attributes, but never resource
+// or scope attributes.
+//
+// Usage example:
+//
+//	HaveDataPoint(HaveAttribute("foo=bar"), HaveDataPointValue(42))
+//	HaveDataPoint(HaveField("Count", BeNumerically(">", 0)))
+func HaveDataPoint(m ty.GomegaMatcher, ms ...ty.GomegaMatcher) ty.GomegaMatcher {
+	ms = append([]ty.GomegaMatcher{m}, ms...)
+	gms, ams := separateAttributeMatchers(ms)
+	return gc.MakeMatcher(func(actual metricdata.Metrics) (bool, error) {
+	nextDataPoint:
+		for attrs, dp := range dataPoints(actual.Data) {
+			for _, gm := range gms {
+				success, err := gm.Match(dp)
+				if err != nil {
+					return false, err
+				}
+				if !success {
+					continue nextDataPoint
+				}
+			}
+			leftover, err := removeMatchingMatchers(attrs, slices.Clone(ams))
+			if err != nil {
+				return false, err
+			}
+			if len(leftover) == 0 {
+				return true, nil
+			}
+		}
+		return false, nil
+	}).WithTemplate("Expected:\n{{.FormattedActual}}\n{{.To}} have a data point matching\n{{format .Data 1}}").
+		WithTemplateData(ms)
+}
@@ -0,0 +1,61 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package motel
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("HaveDataPoint and HaveDataPointValue matchers", func() {
+
+	It("matches a data point", func() {
+		Expect(counter).To(HaveDataPoint(HaveDataPointValue(42)))
+		Expect(counter).To(HaveDataPoint(HaveDataPointValue(42.0), HaveAttribute("method=GET")))
+		Expect(counter).To(HaveDataPoint(HaveDataPointValue(BeNumerically("<", 10)), HaveAttribute("method=POST")))
+		Expect(counter).NotTo(HaveDataPoint(HaveDataPointValue(42), HaveAttribute("method=POST")))
+		Expect(counter).NotTo(HaveDataPoint(HaveDataPointValue(666)))
+	})
+
+	It("matches histogram data points", func() {
+		m := metricdata.Metrics{
+			Data: metricdata.Histogram[float64]{
+				DataPoints: []metricdata.HistogramDataPoint[float64]{
+					{
+						Attributes: attribute.NewSet(attribute.String("foo", "bar")),
+						Count:      3,
+					},
+				},
+			},
+		}
+		Expect(m).To(HaveDataPoint(HaveField("Count", uint64(3)), HaveAttribute("foo")))
+		Expect(m).NotTo(HaveDataPoint(HaveField("Count", uint64(1))))
+		Expect(HaveDataPoint(HaveDataPointValue(3)).Match(m)).Error().To(
+			MatchError(ContainSubstring("HaveDataPointValue expected actual of type")))
+	})
+
+	It("matches float data points", func() {
+		Expect(metricdata.DataPoint[float64]{Value: 1.5}).To(HaveDataPointValue(1.5))
+		Expect(metricdata.DataPoint[float64]{Value: 1.5}).NotTo(HaveDataPointValue(1))
+	})
+
+	It("returns attribute matching errors", func() {
+		Expect(HaveDataPoint(HaveAttribute(BeTrue())).Match(counter)).Error().To(HaveOccurred())
+	})
+
+})
@@ -0,0 +1,48 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package motel
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	"github.com/onsi/gomega/format"
+	gc "github.com/onsi/gomega/gcustom"
+	ty "github.com/onsi/gomega/types"
+)
+
+// HaveDataPointValue succeeds if the actual data point has the expected value. The
+// expected value can be any numeric value that is then compared numerically to
+// the data point value, regardless of the data point's int64 or float64 value
+// type. Alternatively, the expected value can be a [ty.GomegaMatcher].
+//
+// It is an error for actual not to be a [metricdata.DataPoint] of either
+// int64 or float64, as used in [metricdata.Gauge] and [metricdata.Sum]
+// aggregations.
+func HaveDataPointValue(expected any) ty.GomegaMatcher {
+	m := matcherOrNumericallyEqual(expected)
+	return gc.MakeMatcher(func(actual any) (bool, error) {
+		switch actual := actual.(type) {
+		case metricdata.DataPoint[int64]:
+			return m.Match(actual.Value)
+		case metricdata.DataPoint[float64]:
+			return m.Match(actual.Value)
+		}
+		return false, fmt.Errorf("HaveDataPointValue expected actual of type <%T> or <%T>.  Got:\n%s",
+			metricdata.DataPoint[int64]{}, metricdata.DataPoint[float64]{}, format.Object(actual, 1))
+	}).WithTemplate("Expected:\n{{.FormattedActual}}\n{{.To}} have value\n{{format .Data 1}}").
+		WithTemplateData(expected)
+}
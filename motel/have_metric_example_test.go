@@ -0,0 +1,57 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package motel_test
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/metric"
+	sdkmetric "go.opentelemetry.io/otel/sdk/metric"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	"github.com/onsi/gomega"
+
+	. "github.com/thediveo/otelcheck/motel"
+)
+
+func ExampleHaveMetric() {
+	/* only in testable example */ Ω := gomega.NewGomega(func(message string, _ ...int) { panic(message) })
+
+	ctx := context.TODO()
+
+	reader := sdkmetric.NewManualReader()
+	provider := sdkmetric.NewMeterProvider(sdkmetric.WithReader(reader))
+	defer func() { _ = provider.Shutdown(ctx) }()
+
+	counter, _ := provider.Meter("example").Int64Counter("foo.requests",
+		metric.WithUnit("{request}"))
+	counter.Add(ctx, 41, metric.WithAttributes(attribute.String("method", "GET")))
+	counter.Add(ctx, 1, metric.WithAttributes(attribute.String("method", "GET")))
+	counter.Add(ctx, 1, metric.WithAttributes(attribute.String("method", "POST")))
+
+	var rm metricdata.ResourceMetrics
+	_ = reader.Collect(ctx, &rm)
+
+	// notice how we don't need to dig through the resource and scope metrics,
+	// and then through the metric's aggregation data.
+	Ω.Expect(rm).To(HaveMetric(
+		HaveName("foo.requests"),
+		HaveUnit("{request}"),
+		HaveAttribute("service.name"),
+		HaveDataPoint(HaveAttribute("method=GET"), HaveDataPointValue(42)),
+	))
+	// Output:
+}
@@ -0,0 +1,82 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package motel
+
+import (
+	"fmt"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+
+	"github.com/onsi/gomega/format"
+	gc "github.com/onsi/gomega/gcustom"
+	ty "github.com/onsi/gomega/types"
+)
+
+// HaveMetric succeeds if the actual resource metrics or scope metrics contain
+// at least one metric satisfying all specified matchers. It is an error for
+// actual not to be of type [metricdata.ResourceMetrics] (or a pointer to it),
+// or [metricdata.ScopeMetrics].
+//
+// In contrast to [BeAMetric], the attribute matchers passed to HaveMetric also
+// match resource attributes and instrumentation/scope attributes (if present),
+// in addition to data point attributes. As with BeAMetric, any attribute
+// matchers not satisfied by resource or scope attributes must be satisfied by
+// the attributes of the same data point.
+func HaveMetric(m ty.GomegaMatcher, ms ...ty.GomegaMatcher) ty.GomegaMatcher {
+	ms = append([]ty.GomegaMatcher{m}, ms...)
+	gms, ams := separateAttributeMatchers(ms)
+	return gc.MakeMatcher(func(actual any) (bool, error) {
+		switch actual := actual.(type) {
+		case metricdata.ResourceMetrics:
+			return matchResourceMetrics(&actual, gms, ams)
+		case *metricdata.ResourceMetrics:
+			if actual == nil {
+				break
+			}
+			return matchResourceMetrics(actual, gms, ams)
+		case metricdata.ScopeMetrics:
+			return matchScopeMetrics(nil, &actual, gms, ams)
+		}
+		return false, fmt.Errorf("HaveMetric expected actual of type <%T> or <%T>.  Got:\n%s",
+			metricdata.ResourceMetrics{}, metricdata.ScopeMetrics{}, format.Object(actual, 1))
+	}).WithTemplate("Expected:\n{{.FormattedActual}}\n{{.To}} contain a metric matching\n{{format .Data 1}}").
+		WithTemplateData(ms)
+}
+
+// matchResourceMetrics succeeds if any metric of any scope in the passed
+// resource metrics satisfies all passed matchers.
+func matchResourceMetrics(rm *metricdata.ResourceMetrics, gms []ty.GomegaMatcher, ams []attributeMatcher) (bool, error) {
+	for idx := range rm.ScopeMetrics {
+		success, err := matchScopeMetrics(rm.Resource.Set(), &rm.ScopeMetrics[idx], gms, ams)
+		if err != nil || success {
+			return success, err
+		}
+	}
+	return false, nil
+}
+
+// matchScopeMetrics succeeds if any metric in the passed scope metrics
+// satisfies all passed matchers.
+func matchScopeMetrics(res *attribute.Set, sm *metricdata.ScopeMetrics, gms []ty.GomegaMatcher, ams []attributeMatcher) (bool, error) {
+	scopeAttrs := sm.Scope.Attributes
+	for idx := range sm.Metrics {
+		success, err := matchMetric(res, &scopeAttrs, &sm.Metrics[idx], gms, ams)
+		if err != nil || success {
+			return success, err
+		}
+	}
+	return false, nil
+}
@@ -0,0 +1,72 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package motel
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/metric/metricdata"
+	"go.opentelemetry.io/otel/sdk/resource"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("HaveMetric matcher", func() {
+
+	rm := metricdata.ResourceMetrics{
+		Resource: resource.NewSchemaless(attribute.String("service.name", "foobar")),
+		ScopeMetrics: []metricdata.ScopeMetrics{
+			{
+				Scope: instrumentation.Scope{
+					Name:       "foo",
+					Attributes: attribute.NewSet(attribute.Int("scope.id", 42)),
+				},
+				Metrics: []metricdata.Metrics{counter},
+			},
+		},
+	}
+
+	It("rejects invalid actual values", func() {
+		Expect(HaveMetric(HaveName("foo")).Match(42)).Error().To(HaveOccurred())
+		Expect(HaveMetric(HaveName("foo")).Match((*metricdata.ResourceMetrics)(nil))).Error().To(HaveOccurred())
+	})
+
+	It("finds a metric in resource and scope metrics", func() {
+		Expect(rm).To(HaveMetric(HaveName("foo.requests")))
+		Expect(&rm).To(HaveMetric(HaveName("foo.requests")))
+		Expect(rm.ScopeMetrics[0]).To(HaveMetric(HaveName("foo.requests")))
+		Expect(rm).NotTo(HaveMetric(HaveName("bar.requests")))
+	})
+
+	It("matches resource, scope, and data point attributes", func() {
+		Expect(rm).To(HaveMetric(
+			HaveName("foo.requests"),
+			HaveAttribute("service.name=foobar"),
+			HaveAttributeWithValue("scope.id", 42),
+			HaveAttribute("method=GET"),
+			HaveAttributeWithValue("status", 200)))
+		Expect(rm).NotTo(HaveMetric(
+			HaveAttribute("service.name=foobar"),
+			HaveAttribute("method=GET"),
+			HaveAttributeWithValue("status", 404)))
+		Expect(rm.ScopeMetrics[0]).NotTo(HaveMetric(HaveAttribute("service.name")))
+	})
+
+	It("returns attribute matching errors", func() {
+		Expect(HaveMetric(HaveAttribute(BeTrue())).Match(rm)).Error().To(HaveOccurred())
+	})
+
+})
@@ -0,0 +1,40 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package motel
+
+import (
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("metric name, unit, and description matchers", func() {
+
+	It("matches a metric's name", func() {
+		Expect(counter).To(HaveName("foo.requests"))
+		Expect(counter).To(HaveName(HavePrefix("foo.")))
+		Expect(counter).NotTo(HaveName("bar.requests"))
+	})
+
+	It("matches a metric's unit", func() {
+		Expect(counter).To(HaveUnit("{request}"))
+		Expect(counter).NotTo(HaveUnit("s"))
+	})
+
+	It("matches a metric's description", func() {
+		Expect(counter).To(HaveDescription("number of foo requests"))
+		Expect(counter).NotTo(HaveDescription(BeEmpty()))
+	})
+
+})
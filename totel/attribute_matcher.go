@@ -0,0 +1,185 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package totel
+
+import (
+	"errors"
+	"fmt"
+	"strings"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+
+	"github.com/thediveo/otelcheck/lotel/logconv"
+
+	"github.com/onsi/gomega/format"
+	ty "github.com/onsi/gomega/types"
+)
+
+// HaveAttribute succeeds if an OpenTelemetry span has an attribute with the
+// specified key/name (and optional value), including matching resource and
+// instrumentation/scope-level attributes.
+//
+// The expected value passed into the attr parameter can be either a string or
+// [ty.GomegaMatcher]:
+//   - a string in the form of “name” where it must match an attribute key/name
+//     (but not any value), or
+//     in the “name=value” form where it must match both the attribute key/name and
+//     value. Please note that the “name=value” form matches attribute string values
+//     only. Use [HaveAttributeWithValue] instead to match attributes with
+//     non-string values.
+//   - a GomegaMatcher that matches the name only.
+//   - any other type of value is an error.
+//
+// HaveAttribute accepts actual values of types [attribute.KeyValue] and also
+// [sdktrace.ReadOnlySpan]. When used inside [HaveSpanEvent] or [HaveLink],
+// HaveAttribute matches the attributes of span events or links respectively.
+//
+// Usage examples:
+//
+//	HaveAttribute("foo")
+//	HaveAttribute("foo=bar")
+//	HaveAttribute(HaveSuffix("foo"))
+//
+// See also [HaveAttributeWithValue].
+func HaveAttribute(attr any) ty.GomegaMatcher {
+	if s, ok := attr.(string); ok {
+		if nam, value, found := strings.Cut(s, "="); found {
+			return &HaveAttributeMatcher{
+				name:         nam,
+				value:        value,
+				nameMatcher:  matcherOrEqual(nam),
+				valueMatcher: matcherOrEqual(value),
+			}
+		}
+	}
+	return &HaveAttributeMatcher{
+		name:         attr,
+		value:        nil,
+		nameMatcher:  matcherOrEqual(attr),
+		valueMatcher: nil,
+	}
+}
+
+// HaveAttributeWithValue succeeds if an OpenTelemetry span has an attribute
+// with the specified key/name and value, including matching resource and
+// instrumentation/scope-level attributes.
+//
+// The value passed into the name parameter can be either a string or a
+// [ty.GomegaMatcher].
+//
+// The value passed into the value parameter can be one of the following, all
+// other values are an error:
+//   - bool
+//   - int, int64
+//   - float32, float64
+//   - string
+//   - []bool, []int, []int64, []float32, []float64, []string
+//   - [ty.GomegaMatcher]
+//
+// Usage examples:
+//
+//	HaveAttributeWithValue("foo", "bar")
+//	HaveAttributeWithValue("foo", 42)
+//	HaveAttributeWithValue("foo", []string{"bar", "baz"})
+//
+// See also [HaveAttribute].
+func HaveAttributeWithValue(name, value any) ty.GomegaMatcher {
+	valueMatcher, err := matcherOrEqualNilInclusive(value, logconv.TryCanonize)
+	return &HaveAttributeMatcher{
+		name:         name,
+		value:        value,
+		nameMatcher:  matcherOrEqual(name),
+		valueMatcher: valueMatcher,
+		err:          err,
+	}
+}
+
+// HaveAttributeMatcher matches either all attributes of a
+// [sdktrace.ReadOnlySpan] (including resource and instrumentation scope
+// attributes) or an [attribute.KeyValue] against its spec. It allows matching
+// against the name/key part only, or both name/key and value matching.
+//
+// See also: [HaveAttribute] and [HaveAttributeWithValue].
+type HaveAttributeMatcher struct {
+	name         any
+	value        any
+	nameMatcher  ty.GomegaMatcher // actual will be of type string
+	valueMatcher ty.GomegaMatcher // actual will be of type any (via logconv.FromAttribute and logconv.Any)
+	err          error            // deferred expected value conversion error, if any
+}
+
+var (
+	_ attributeMatcher = (*HaveAttributeMatcher)(nil)
+	_ ty.GomegaMatcher = (*HaveAttributeMatcher)(nil)
+)
+
+// matchAttribute is the optimized entry that succeeds if the passed name and
+// value match this attribute matcher's specification.
+func (m *HaveAttributeMatcher) matchAttribute(name string, value any) (bool, error) {
+	if m.nameMatcher == nil {
+		return false, fmt.Errorf("HaveAttributeMatcher: name matcher must not be <nil>")
+	}
+	if m.err != nil {
+		return false, fmt.Errorf("HaveAttributeMatcher: cannot convert expected value: %w", m.err)
+	}
+	if m.value != nil && m.valueMatcher == nil {
+		return false, fmt.Errorf("HaveAttributeMatcher: expected value to be non-nil or types.GomegaMatcher.  Got:\n%T",
+			m.value)
+	}
+	success, err := m.nameMatcher.Match(name)
+	if err != nil || !success {
+		return false, err
+	}
+	if m.valueMatcher == nil { // no value to match, so we've found a matching attribute
+		return true, nil
+	}
+	return m.valueMatcher.Match(value)
+}
+
+func (m *HaveAttributeMatcher) Match(actual any) (success bool, err error) {
+	if actual == nil {
+		return false, errors.New("refusing to match <nil>")
+	}
+	if m.err != nil {
+		return false, fmt.Errorf("HaveAttributeMatcher: cannot convert expected value: %w", m.err)
+	}
+	switch actual := actual.(type) {
+	case attribute.KeyValue:
+		return m.matchAttribute(string(actual.Key), logconv.Any(logconv.FromAttribute(actual.Value)))
+	case sdktrace.ReadOnlySpan:
+		return containsAttributes(actual, []attributeMatcher{m})
+	}
+	return false, fmt.Errorf("HaveAttribute expected actual of type <%T> or <sdktrace.ReadOnlySpan>.  Got:\n%s",
+		attribute.KeyValue{}, format.Object(actual, 1))
+}
+
+func (m *HaveAttributeMatcher) expected() string {
+	expected := "key:\n" + format.Object(m.name, 1)
+	if m.value != nil {
+		expected += "\nvalue:\n" + format.Object(m.value, 1)
+	}
+	return expected
+}
+
+func (m *HaveAttributeMatcher) FailureMessage(actual any) (message string) {
+	return fmt.Sprintf("Expected\n%s\nto have attribute\n%s",
+		format.Object(actual, 1), format.IndentString(m.expected(), 1))
+}
+
+func (m *HaveAttributeMatcher) NegatedFailureMessage(actual any) (message string) {
+	return fmt.Sprintf("Expected\n%s\nnot to have attribute\n%s",
+		format.Object(actual, 1), format.IndentString(m.expected(), 1))
+}
@@ -0,0 +1,62 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package totel
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	ty "github.com/onsi/gomega/types"
+	. "github.com/thediveo/otelcheck/x/iff"
+)
+
+var _ = Describe("HaveAttribute(WithValue) matchers", func() {
+
+	DescribeTable("matches attributes",
+		func(actual any, m ty.GomegaMatcher, match bool) {
+			If(match, Assertion.To, Assertion.NotTo)(Expect(actual), m)
+		},
+		Entry(nil, attribute.String("foo", "bar"), HaveAttribute("foo"), true),
+		Entry(nil, attribute.String("foo", "bar"), HaveAttribute("foo=bar"), true),
+		Entry(nil, attribute.String("foo", "bar"), HaveAttribute("foo=baz"), false),
+		Entry(nil, attribute.Int("foo", 42), HaveAttributeWithValue("foo", 42), true),
+		Entry(nil, attribute.StringSlice("foo", []string{"bar"}), HaveAttributeWithValue("foo", []string{"bar"}), true),
+		Entry(nil, newSpan(), HaveAttribute("http.request.method=GET"), true),
+		Entry(nil, newSpan(), HaveAttribute("service.name"), true),
+		Entry(nil, newSpan(), HaveAttributeWithValue("scope.id", 42), true),
+		Entry(nil, newSpan(), HaveAttribute("exception.message"), false),
+	)
+
+	It("returns errors", func() {
+		Expect(HaveAttribute("foo").Match(nil)).Error().To(HaveOccurred())
+		Expect(HaveAttribute("foo").Match(42)).Error().To(
+			MatchError(ContainSubstring("HaveAttribute expected actual of type")))
+		Expect(HaveAttribute(BeTrue()).Match(newSpan())).Error().To(HaveOccurred())
+		Expect((&HaveAttributeMatcher{}).Match(attribute.String("foo", "bar"))).Error().To(
+			MatchError(ContainSubstring("name matcher must not be <nil>")))
+		Expect(HaveAttributeWithValue("foo", make(chan int)).Match(attribute.String("foo", "bar"))).Error().To(
+			MatchError(ContainSubstring("cannot convert expected value")))
+	})
+
+	It("returns failure messages", func() {
+		m := HaveAttributeWithValue("foo", 42)
+		Expect(m.FailureMessage(attribute.String("foo", "bar"))).To(
+			MatchRegexp(`(?s)to have attribute\s+key:.*foo\s+value:.*42`))
+		Expect(m.NegatedFailureMessage(attribute.String("foo", "bar"))).To(
+			ContainSubstring("not to have attribute"))
+	})
+
+})
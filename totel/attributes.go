@@ -0,0 +1,133 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package totel
+
+import (
+	"iter"
+	"slices"
+
+	"go.opentelemetry.io/otel/attribute"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+
+	"github.com/thediveo/otelcheck/lotel/logconv"
+	intslices "github.com/thediveo/otelcheck/x/slices"
+
+	ty "github.com/onsi/gomega/types"
+)
+
+// attributeMatcher marks a Gomega matcher to match OTel span attributes, as
+// well as resource and scope attributes.
+type attributeMatcher interface {
+	// try to match an attribute by its name and any-fied/canonized value.
+	matchAttribute(name string, value any) (bool, error)
+}
+
+// containsAttributes succeeds if all passed attribute matchers match on (some
+// of) the passed span's attributes, including resource and scope attributes.
+func containsAttributes(s sdktrace.ReadOnlySpan, attrms []attributeMatcher) (bool, error) {
+	scopeAttrs := s.InstrumentationScope().Attributes
+	attrms = slices.Clone(attrms)
+	var err error
+	for _, attrs := range []iter.Seq[attribute.KeyValue]{
+		setAttributes(s.Resource().Set()),
+		setAttributes(&scopeAttrs),
+		slices.Values(s.Attributes()),
+	} {
+		attrms, err = removeMatchingMatchers(attrs, attrms)
+		if err != nil {
+			return false, err
+		}
+		if len(attrms) == 0 {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// setAttributes returns an iterator over the attributes in the passed set.
+func setAttributes(set *attribute.Set) iter.Seq[attribute.KeyValue] {
+	return func(yield func(attribute.KeyValue) bool) {
+		it := set.Iter()
+		for it.Next() {
+			if !yield(it.Attribute()) {
+				return
+			}
+		}
+	}
+}
+
+// removeMatchingMatchers checks which attribute matchers match on the
+// passed attributes and then returns only the "left-over" non-matching
+// matchers.
+func removeMatchingMatchers(attrs iter.Seq[attribute.KeyValue], attrms []attributeMatcher) ([]attributeMatcher, error) {
+nextAttribute:
+	for attr := range attrs {
+		if len(attrms) == 0 {
+			return attrms, nil
+		}
+		value := logconv.Any(logconv.FromAttribute(attr.Value))
+		for midx, m := range attrms {
+			success, err := m.matchAttribute(string(attr.Key), value)
+			if err != nil {
+				return nil, err
+			}
+			if success {
+				attrms = intslices.DeleteUnordered(attrms, midx)
+				continue nextAttribute
+			}
+		}
+	}
+	return attrms, nil
+}
+
+// matchAllAttributes succeeds if all passed attribute matchers match on (some
+// of) the passed attributes.
+func matchAllAttributes(attrs []attribute.KeyValue, attrms []attributeMatcher) (bool, error) {
+	if len(attrms) == 0 {
+		return true, nil
+	}
+	leftover, err := removeMatchingMatchers(slices.Values(attrs), slices.Clone(attrms))
+	if err != nil {
+		return false, err
+	}
+	return len(leftover) == 0, nil
+}
+
+// separateAttributeMatchers separates a list of matchers into a list of
+// attribute matchers as well as the list of non-attribute matchers.
+func separateAttributeMatchers(ms []ty.GomegaMatcher) ([]ty.GomegaMatcher, []attributeMatcher) {
+	gms := make([]ty.GomegaMatcher, 0, len(ms))
+	var ams []attributeMatcher
+	for _, m := range ms {
+		if am, ok := m.(attributeMatcher); ok {
+			ams = append(ams, am)
+			continue
+		}
+		gms = append(gms, m)
+	}
+	return gms, ams
+}
+
+// matchAll succeeds if actual satisfies all passed matchers, stopping at the
+// first matcher not being satisfied or returning an error.
+func matchAll(actual any, ms []ty.GomegaMatcher) (bool, error) {
+	for _, m := range ms {
+		success, err := m.Match(actual)
+		if err != nil || !success {
+			return false, err
+		}
+	}
+	return true, nil
+}
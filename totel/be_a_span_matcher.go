@@ -0,0 +1,42 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package totel
+
+import (
+	"errors"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+
+	gc "github.com/onsi/gomega/gcustom"
+	ty "github.com/onsi/gomega/types"
+)
+
+// BeASpan succeeds if the actual span satisfies all specified matchers. It is
+// an error for actual not to be of type [sdktrace.ReadOnlySpan].
+func BeASpan(m ty.GomegaMatcher, ms ...ty.GomegaMatcher) ty.GomegaMatcher {
+	ms = append([]ty.GomegaMatcher{m}, ms...)
+	gms, ams := separateAttributeMatchers(ms)
+	return gc.MakeMatcher(func(s sdktrace.ReadOnlySpan) (bool, error) {
+		if s == nil {
+			return false, errors.New("refusing to match <nil>")
+		}
+		success, err := matchAll(s, gms)
+		if err != nil || !success {
+			return false, err
+		}
+		return containsAttributes(s, ams)
+	}).WithTemplate("Expected:\n{{.FormattedActual}}\n{{.To}} match\n{{format .Data 1}}").
+		WithTemplateData(ms)
+}
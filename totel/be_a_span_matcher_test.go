@@ -0,0 +1,77 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package totel
+
+import (
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/trace"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("BeASpan matcher", func() {
+
+	It("fails when not given a span", func() {
+		Expect(BeASpan(HaveSpanName("foo")).Match(42)).Error().To(HaveOccurred())
+		Expect(BeASpan(HaveSpanName("foo")).Match(nil)).Error().To(HaveOccurred())
+	})
+
+	It("matches when all matchers are satisfied", func() {
+		Expect(newSpan()).To(BeASpan(
+			HaveSpanName("GET /foo"),
+			HaveSpanKind(trace.SpanKindServer),
+			HaveStatus(codes.Error),
+			HaveAttributeWithValue("http.response.status_code", 500),
+			HaveAttribute("service.name=foobar"),
+			HaveAttributeWithValue("scope.id", 42),
+		))
+	})
+
+	It("doesn't match when not all matchers are satisfied", func() {
+		Expect(newSpan()).NotTo(BeASpan(
+			HaveSpanName("GET /foo"),
+			HaveSpanKind(trace.SpanKindClient)))
+		Expect(newSpan()).NotTo(BeASpan(
+			HaveSpanName("GET /foo"),
+			HaveAttribute("http.route")))
+	})
+
+})
+
+var _ = Describe("span matchers", func() {
+
+	It("matches span names", func() {
+		Expect(newSpan()).To(HaveSpanName("GET /foo"))
+		Expect(newSpan()).To(HaveSpanName(HavePrefix("GET")))
+		Expect(newSpan()).NotTo(HaveSpanName("GET /bar"))
+		Expect(HaveSpanName("foo").Match(nil)).Error().To(HaveOccurred())
+	})
+
+	It("matches span kinds", func() {
+		Expect(newSpan()).To(HaveSpanKind(trace.SpanKindServer))
+		Expect(newSpan()).NotTo(HaveSpanKind(trace.SpanKindInternal))
+	})
+
+	It("matches span status", func() {
+		Expect(newSpan()).To(HaveStatus(codes.Error))
+		Expect(newSpan()).To(HaveStatus(codes.Error, "D'OH!"))
+		Expect(newSpan()).To(HaveStatus(Not(Equal(codes.Ok)), ContainSubstring("OH")))
+		Expect(newSpan()).NotTo(HaveStatus(codes.Error, "DOH"))
+		Expect(newSpan()).NotTo(HaveStatus(codes.Ok))
+		Expect(HaveStatus(codes.Ok).Match(nil)).Error().To(HaveOccurred())
+	})
+
+})
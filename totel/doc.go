@@ -0,0 +1,15 @@
+/*
+Package totel provides Gomega matchers for reasoning about OpenTelemetry trace
+spans.
+
+The matchers work on ended spans in their read-only form of
+[sdktrace.ReadOnlySpan], such as received from the span channel of a
+[github.com/thediveo/otelcheck/exporters/chanspan.Exporter]. For instance:
+
+	Eventually(ch).Should(Receive(BeASpan(
+		HaveSpanName("GET /foo"),
+		HaveSpanKind(trace.SpanKindServer),
+		HaveStatus(codes.Ok),
+		HaveAttributeWithValue("http.response.status_code", 200))))
+*/
+package totel
@@ -0,0 +1,64 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package totel_test
+
+import (
+	"context"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/onsi/gomega"
+
+	"github.com/thediveo/otelcheck/exporters/chanspan"
+	. "github.com/thediveo/otelcheck/totel"
+)
+
+// This is a complete example showing how to create a tracer for testing
+// purposes, starting and ending a span, and then asserting the correct span
+// arrives in the channel the tracer exports into.
+func Example() {
+	/* only in testable example */ Ω := gomega.NewGomega(func(message string, _ ...int) { panic(message) })
+
+	// only in testable example, so when no suitable test context is at hand.
+	ctx, cancel := context.WithTimeout(context.TODO(), 30*time.Second)
+	defer cancel()
+
+	exporter, _ := chanspan.New(chanspan.WithCap(10))
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithSpanProcessor(sdktrace.NewSimpleSpanProcessor(exporter)))
+	defer func() { _ = provider.Shutdown(ctx) }()
+	ch := exporter.Ch()
+
+	_, span := provider.Tracer("example").Start(ctx, "GET /foo",
+		trace.WithSpanKind(trace.SpanKindServer))
+	span.SetAttributes(attribute.Int("http.response.status_code", 500))
+	span.AddEvent("exception", trace.WithAttributes(attribute.String("exception.message", "D'OH!")))
+	span.SetStatus(codes.Error, "D'OH!")
+	span.End()
+
+	Ω.Eventually(ch).Should(gomega.Receive(BeASpan(
+		HaveSpanName("GET /foo"),
+		HaveSpanKind(trace.SpanKindServer),
+		HaveStatus(codes.Error),
+		HaveSpanEvent("exception", HaveAttribute("exception.message=D'OH!")),
+		HaveAttributeWithValue("http.response.status_code", 500),
+		HaveAttribute("service.name"),
+	)))
+	// Output:
+}
@@ -0,0 +1,90 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package totel
+
+import (
+	"errors"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/trace"
+
+	gc "github.com/onsi/gomega/gcustom"
+	ty "github.com/onsi/gomega/types"
+)
+
+// HaveLink succeeds if the actual span has a link to the expected span context
+// that additionally satisfies all optionally specified matchers.
+//
+// The expected span context can be one of the following, all other values are
+// an error:
+//   - [trace.SpanContext]: the link must have the same trace and span IDs.
+//   - [sdktrace.ReadOnlySpan]: the link must have the same trace and span IDs as
+//     the span's context.
+//   - [ty.GomegaMatcher]: matches the link's [trace.SpanContext].
+//   - nil: matches any link.
+//
+// Attribute matchers, such as [HaveAttribute], match the link's attributes.
+// All other matchers get passed the link of type [sdktrace.Link].
+//
+// Usage examples:
+//
+//	HaveLink(otherSpan.SpanContext())
+//	HaveLink(nil, HaveAttribute("foo=bar"))
+func HaveLink(to any, ms ...ty.GomegaMatcher) ty.GomegaMatcher {
+	gms, ams := separateAttributeMatchers(ms)
+	return gc.MakeMatcher(func(s sdktrace.ReadOnlySpan) (bool, error) {
+		if s == nil {
+			return false, errors.New("refusing to match <nil>")
+		}
+		for _, link := range s.Links() {
+			success, err := matchSpanContext(link.SpanContext, to)
+			if err != nil {
+				return false, err
+			}
+			if !success {
+				continue
+			}
+			success, err = matchAll(link, gms)
+			if err != nil {
+				return false, err
+			}
+			if !success {
+				continue
+			}
+			success, err = matchAllAttributes(link.Attributes, ams)
+			if err != nil || success {
+				return success, err
+			}
+		}
+		return false, nil
+	}).WithTemplate("Expected:\n{{.FormattedActual}}\n{{.To}} have a link matching\n{{format .Data 1}}").
+		WithTemplateData(append([]any{to}, anySlice(ms)...))
+}
+
+// matchSpanContext succeeds if the actual span context matches the expected
+// span context, span, or span context matcher.
+func matchSpanContext(actual trace.SpanContext, expected any) (bool, error) {
+	switch expected := expected.(type) {
+	case nil:
+		return true, nil
+	case trace.SpanContext:
+		return actual.TraceID() == expected.TraceID() && actual.SpanID() == expected.SpanID(), nil
+	case sdktrace.ReadOnlySpan:
+		return matchSpanContext(actual, expected.SpanContext())
+	case ty.GomegaMatcher:
+		return expected.Match(actual)
+	}
+	return false, errors.New("HaveLink expected a trace.SpanContext, sdktrace.ReadOnlySpan, or GomegaMatcher")
+}
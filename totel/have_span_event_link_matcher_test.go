@@ -0,0 +1,62 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package totel
+
+import (
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("HaveSpanEvent and HaveLink matchers", func() {
+
+	It("matches span events", func() {
+		Expect(newSpan()).To(HaveSpanEvent("exception"))
+		Expect(newSpan()).To(HaveSpanEvent(HavePrefix("exc"),
+			HaveAttribute("exception.message=D'OH!")))
+		Expect(newSpan()).To(HaveSpanEvent("exception",
+			HaveField("Name", "exception")))
+		Expect(newSpan()).NotTo(HaveSpanEvent("exception",
+			HaveField("Name", "foo")))
+		Expect(newSpan()).NotTo(HaveSpanEvent("exception",
+			HaveAttribute("http.request.method")))
+		Expect(newSpan()).NotTo(HaveSpanEvent("foo"))
+	})
+
+	It("matches span links", func() {
+		Expect(newSpan()).To(HaveLink(nil))
+		Expect(newSpan()).To(HaveLink(linkedSpanContext, HaveAttribute("foo=bar")))
+		Expect(newSpan()).To(HaveLink(HaveField("SpanID()", linkedSpanContext.SpanID())))
+		Expect(newSpan()).NotTo(HaveLink(otherSpanContext))
+		Expect(newSpan()).NotTo(HaveLink(linkedSpanContext, HaveAttribute("foo=baz")))
+		Expect(newSpan()).NotTo(HaveLink(nil, HaveField("SpanContext.IsSampled()", true)))
+	})
+
+	It("matches span links to spans", func() {
+		Expect(newSpan()).To(HaveLink(tracetest.SpanStub{SpanContext: linkedSpanContext}.Snapshot()))
+		Expect(newSpan()).NotTo(HaveLink(tracetest.SpanStub{SpanContext: otherSpanContext}.Snapshot()))
+	})
+
+	It("returns errors", func() {
+		Expect(HaveSpanEvent("foo").Match(nil)).Error().To(HaveOccurred())
+		Expect(HaveSpanEvent(BeTrue()).Match(newSpan())).Error().To(HaveOccurred())
+		Expect(HaveSpanEvent("exception", HaveAttribute(BeTrue())).Match(newSpan())).Error().To(HaveOccurred())
+		Expect(HaveLink(nil).Match(nil)).Error().To(HaveOccurred())
+		Expect(HaveLink(42).Match(newSpan())).Error().To(HaveOccurred())
+		Expect(HaveLink(nil, HaveAttribute(BeTrue())).Match(newSpan())).Error().To(HaveOccurred())
+	})
+
+})
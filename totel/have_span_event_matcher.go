@@ -0,0 +1,76 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package totel
+
+import (
+	"errors"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+
+	gc "github.com/onsi/gomega/gcustom"
+	ty "github.com/onsi/gomega/types"
+)
+
+// HaveSpanEvent succeeds if the actual span has an event with the expected
+// name that additionally satisfies all optionally specified matchers. The
+// expected name can be a string or a [ty.GomegaMatcher].
+//
+// Attribute matchers, such as [HaveAttribute], match the event's attributes.
+// All other matchers get passed the event of type [sdktrace.Event].
+//
+// Usage examples:
+//
+//	HaveSpanEvent("exception")
+//	HaveSpanEvent("exception", HaveAttribute("exception.message=D'OH!"))
+func HaveSpanEvent(name any, ms ...ty.GomegaMatcher) ty.GomegaMatcher {
+	namem := matcherOrEqual(name)
+	gms, ams := separateAttributeMatchers(ms)
+	return gc.MakeMatcher(func(s sdktrace.ReadOnlySpan) (bool, error) {
+		if s == nil {
+			return false, errors.New("refusing to match <nil>")
+		}
+		for _, event := range s.Events() {
+			success, err := namem.Match(event.Name)
+			if err != nil {
+				return false, err
+			}
+			if !success {
+				continue
+			}
+			success, err = matchAll(event, gms)
+			if err != nil {
+				return false, err
+			}
+			if !success {
+				continue
+			}
+			success, err = matchAllAttributes(event.Attributes, ams)
+			if err != nil || success {
+				return success, err
+			}
+		}
+		return false, nil
+	}).WithTemplate("Expected:\n{{.FormattedActual}}\n{{.To}} have an event matching\n{{format .Data 1}}").
+		WithTemplateData(append([]any{name}, anySlice(ms)...))
+}
+
+// anySlice returns the passed matchers as a slice of any values.
+func anySlice(ms []ty.GomegaMatcher) []any {
+	as := make([]any, 0, len(ms))
+	for _, m := range ms {
+		as = append(as, m)
+	}
+	return as
+}
@@ -0,0 +1,56 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package totel
+
+import (
+	"errors"
+
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+
+	gc "github.com/onsi/gomega/gcustom"
+	ty "github.com/onsi/gomega/types"
+)
+
+// HaveStatus succeeds if the actual span has the expected status code and
+// optionally also the expected status description. The expected status code
+// can be a [go.opentelemetry.io/otel/codes.Code] or a [ty.GomegaMatcher]. The
+// optional expected description can be a string or a [ty.GomegaMatcher].
+//
+// Usage examples:
+//
+//	HaveStatus(codes.Ok)
+//	HaveStatus(codes.Error, "D'OH!")
+//	HaveStatus(codes.Error, ContainSubstring("OH"))
+func HaveStatus(code any, description ...any) ty.GomegaMatcher {
+	codem := matcherOrEqual(code)
+	var descm ty.GomegaMatcher
+	expected := []any{code}
+	if len(description) > 0 {
+		descm = matcherOrEqual(description[0])
+		expected = append(expected, description[0])
+	}
+	return gc.MakeMatcher(func(s sdktrace.ReadOnlySpan) (bool, error) {
+		if s == nil {
+			return false, errors.New("refusing to match <nil>")
+		}
+		status := s.Status()
+		success, err := codem.Match(status.Code)
+		if err != nil || !success || descm == nil {
+			return success, err
+		}
+		return descm.Match(status.Description)
+	}).WithTemplate("Expected:\n{{.FormattedActual}}\n{{.To}} have status\n{{format .Data 1}}").
+		WithTemplateData(expected)
+}
@@ -0,0 +1,69 @@
+// Copyright 2025 Harald Albrecht.
+//
+// Licensed under the Apache License, Version 2.0 (the "License"); you may not
+// use this file except in compliance with the License. You may obtain a copy
+// of the License at
+//
+//    http://www.apache.org/licenses/LICENSE-2.0
+//
+// Unless required by applicable law or agreed to in writing, software
+// distributed under the License is distributed on an "AS IS" BASIS, WITHOUT
+// WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied. See the
+// License for the specific language governing permissions and limitations
+// under the License.
+
+package totel
+
+import (
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/sdk/instrumentation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+var (
+	linkedSpanContext = trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{0x01, 0x02},
+		SpanID:  trace.SpanID{0x03, 0x04},
+	})
+	otherSpanContext = trace.NewSpanContext(trace.SpanContextConfig{
+		TraceID: trace.TraceID{0x01, 0x02},
+		SpanID:  trace.SpanID{0x05, 0x06},
+	})
+)
+
+// newSpan returns a new read-only span for testing.
+func newSpan() sdktrace.ReadOnlySpan {
+	return tracetest.SpanStub{
+		Name:     "GET /foo",
+		SpanKind: trace.SpanKindServer,
+		Status: sdktrace.Status{
+			Code:        codes.Error,
+			Description: "D'OH!",
+		},
+		Attributes: []attribute.KeyValue{
+			attribute.String("http.request.method", "GET"),
+			attribute.Int("http.response.status_code", 500),
+		},
+		Events: []sdktrace.Event{
+			{
+				Name:       "exception",
+				Attributes: []attribute.KeyValue{attribute.String("exception.message", "D'OH!")},
+			},
+		},
+		Links: []sdktrace.Link{
+			{
+				SpanContext: linkedSpanContext,
+				Attributes:  []attribute.KeyValue{attribute.String("foo", "bar")},
+			},
+		},
+		Resource: resource.NewSchemaless(attribute.String("service.name", "foobar")),
+		InstrumentationScope: instrumentation.Scope{
+			Name:       "foo",
+			Attributes: attribute.NewSet(attribute.Int("scope.id", 42)),
+		},
+	}.Snapshot()
+}